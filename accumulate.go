@@ -0,0 +1,140 @@
+package fit
+
+import "math"
+
+// accumulatorKey identifies an accumulated field of a global message. Fields
+// with subfields are kept apart by the value of the field selecting them, as
+// the cycles of a monitoring message count steps or strokes depending on its
+// activity_type.
+type accumulatorKey struct {
+	message   GlobalMessageType
+	number    uint8
+	reference uint64
+}
+
+// accumulator holds the running total of a field along with the last raw
+// value seen, which is only as wide as the field or component it came from.
+type accumulator struct {
+	last  uint64
+	value uint64
+}
+
+// accumulators reconstructs the running totals of fields the profile marks
+// as accumulated, such as the 12 bit distance of compressed_speed_distance,
+// which would otherwise roll over every few kilometres.
+type accumulators map[accumulatorKey]*accumulator
+
+// Accumulate sets the running totals of the accumulated fields of dm. Fields
+// read from the message keep their raw value and hold the total in Total,
+// while fields expanded from accumulated components hold it as their value.
+// Accumulated fields read from the message in full, such as the distance of
+// a record, restart the totals their components add to.
+func (a *accumulators) Accumulate(dm *DataMessage) {
+	profile := ProfileMessages[dm.GlobalMessageType]
+	if profile == nil {
+		return
+	}
+	if *a == nil {
+		*a = accumulators{}
+	}
+
+	for i := range dm.Fields {
+		f := &dm.Fields[i]
+		pf, ok := profile.Fields[f.Number]
+		if !ok {
+			continue
+		}
+
+		if !f.Expanded {
+			if pf.Accumulate {
+				if v, ok := f.Uint64(); ok {
+					bits := uint8(BaseTypeNumber_Infos[f.BaseType].Size * 8)
+					f.Total = a.accumulate(dm.accumulatorKey(profile, f.Number), v, bits)
+					f.Accumulated = true
+				}
+			}
+			if c, ok := accumulatedComponent(profile, f.Number); ok {
+				if v, ok := componentRaw(f, c); ok {
+					a.set(dm.accumulatorKey(profile, f.Number), v, c.Bits)
+				}
+			}
+		}
+
+		components := pf.Components
+		if sf := dm.subfield(pf); sf != nil {
+			components = sf.Components
+		}
+		unpackComponents(f, components, func(c ProfileComponent, raw uint64) {
+			if !c.Accumulate {
+				return
+			}
+			dest, ok := dm.Field(c.Number)
+			if !ok || !dest.Expanded {
+				return
+			}
+			value := a.accumulate(dm.accumulatorKey(profile, c.Number), raw, c.Bits)
+			dest.Value = componentField(profile.Fields[c.Number], c, value).Value
+		})
+	}
+}
+
+// accumulatorKey returns the key of the running total of the field of m with
+// the given number.
+func (m *DataMessage) accumulatorKey(profile *ProfileMessage, number uint8) accumulatorKey {
+	key := accumulatorKey{message: m.GlobalMessageType, number: number}
+
+	pf, ok := profile.Fields[number]
+	if !ok || len(pf.Subfields) == 0 || len(pf.Subfields[0].References) == 0 {
+		return key
+	}
+	key.reference = math.MaxUint64
+	if f, ok := m.Field(pf.Subfields[0].References[0].Number); ok {
+		if v, ok := f.Uint64(); ok {
+			key.reference = v
+		}
+	}
+	return key
+}
+
+// accumulate adds the difference between raw and the last raw value of the
+// field, modulo the width of the field, to its running total.
+func (a accumulators) accumulate(key accumulatorKey, raw uint64, bits uint8) uint64 {
+	acc, ok := a[key]
+	if !ok {
+		acc = new(accumulator)
+		a[key] = acc
+	}
+
+	mask := uint64(1)<<bits - 1
+	acc.value += (raw - acc.last) & mask
+	acc.last = raw & mask
+	return acc.value
+}
+
+// set restarts the running total of a field at value.
+func (a accumulators) set(key accumulatorKey, value uint64, bits uint8) {
+	a[key] = &accumulator{
+		last:  value & (uint64(1)<<bits - 1),
+		value: value,
+	}
+}
+
+// accumulatedComponent returns an accumulated component of the message that
+// expands into the field with the given number.
+func accumulatedComponent(profile *ProfileMessage, number uint8) (ProfileComponent, bool) {
+	for _, pf := range profile.Fields {
+		for _, c := range pf.Components {
+			if c.Accumulate && c.Number == number {
+				return c, true
+			}
+		}
+		for _, sf := range pf.Subfields {
+			for _, c := range sf.Components {
+				if c.Accumulate && c.Number == number {
+					return c, true
+				}
+			}
+		}
+	}
+	return ProfileComponent{}, false
+}
@@ -0,0 +1,138 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAccumulate(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		records  [][]byte
+		field    string
+		expected []float64
+	}{
+		{
+			name: "compressed distance",
+			records: [][]byte{
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{8, 3, 0x0D}),
+				testDataRecord(0, 0x00, 0x00, 0xFA),
+				// The 12 bit distance rolls over from 4000 to 100.
+				testDataRecord(0, 0x00, 0x40, 0x06),
+				testDefinitionRecord(ArchitectureLittleEndian, 1, 20, [3]byte{5, 4, 0x86}),
+				testDataRecord(1, 0xA0, 0x86, 0x01, 0x00),
+				testDataRecord(0, 0x00, 0x20, 0xEB),
+			},
+			field:    "distance",
+			expected: []float64{250, 262.25, 1000, 1003.13},
+		},
+		{
+			name: "cycles",
+			records: [][]byte{
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{18, 1, 0x02}),
+				testDataRecord(0, 250),
+				testDataRecord(0, 5),
+				testDataRecord(0, 5),
+			},
+			field:    "total_cycles",
+			expected: []float64{250, 261, 261},
+		},
+	} {
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(testFileBytes(test.records...))); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		values := []float64{}
+		for _, record := range f.Records {
+			if record.DataMessage == nil {
+				continue
+			}
+			field, ok := record.DataMessage.FieldByName(test.field)
+			if !ok {
+				t.Fatalf("%s: expected field %s", test.name, test.field)
+			}
+			v, _ := field.Scaled()
+			values = append(values, v)
+		}
+
+		if len(values) != len(test.expected) {
+			t.Fatalf("%s: expected %v, got %v", test.name, test.expected, values)
+		}
+		for i := range values {
+			if values[i] != test.expected[i] {
+				t.Errorf("%s: expected %v, got %v", test.name, test.expected, values)
+				break
+			}
+		}
+	}
+}
+
+func TestAccumulateField(t *testing.T) {
+	// The cycles of a monitoring message are kept per activity type.
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 55, [3]byte{5, 1, 0x00}, [3]byte{3, 4, 0x86}),
+		testDataRecord(0, 6, 0xF0, 0xFF, 0xFF, 0xFF),
+		testDataRecord(0, 2, 0x32, 0x00, 0x00, 0x00),
+		testDataRecord(0, 6, 0x10, 0x00, 0x00, 0x00),
+		testDataRecord(0, 2, 0x3C, 0x00, 0x00, 0x00),
+	)
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expected := range []struct {
+		raw, total uint64
+	}{
+		{0xFFFFFFF0, 0xFFFFFFF0},
+		{50, 50},
+		{0x10, 0x100000010},
+		{60, 60},
+	} {
+		field, ok := f.Records[i+1].DataMessage.Field(3)
+		if !ok {
+			t.Fatalf("message %d: expected cycles", i)
+		}
+		if v, _ := field.Uint64(); v != expected.raw || !field.Accumulated || field.Total != expected.total {
+			t.Errorf("message %d: expected %d with total %d, got %d with total %d", i, expected.raw, expected.total, v, field.Total)
+		}
+	}
+
+	// JSON output carries the total, scaled like the value: walking cycles
+	// are steps, with a scale of 1, and cycling cycles strokes, with 2.
+	for i, expected := range map[int]string{
+		1: `"value":25,"raw_value":50,"total":25,"units":"strokes"`,
+		2: `"value":16,"total":4294967312,"units":"steps"`,
+	} {
+		field, _ := f.Records[i+1].DataMessage.Field(3)
+		data, err := json.Marshal(field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("message %d: expected %s in %s", i, expected, data)
+		}
+	}
+}
+
+func TestAccumulatePerFile(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{18, 1, 0x02}),
+		testDataRecord(0, 250),
+	)
+
+	for i := 0; i < 2; i++ {
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+			t.Fatal(err)
+		}
+		field, _ := f.Records[1].DataMessage.FieldByName("total_cycles")
+		if v, ok := field.Uint64(); !ok || v != 250 {
+			t.Errorf("file %d: expected 250, got %v", i, field.Value)
+		}
+	}
+}
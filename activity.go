@@ -0,0 +1,110 @@
+package fit
+
+// ActivityFile groups the messages of a decoded activity file by type.
+type ActivityFile struct {
+	FileId      FileIdMsg
+	FileCreator *FileCreatorMsg
+	Activity    *ActivityMsg
+	Sessions    []SessionMsg
+	Laps        []LapMsg
+	Lengths     []LengthMsg
+	Records     []RecordMsg
+	Events      []EventMsg
+	DeviceInfos []DeviceInfoMsg
+	Hrvs        []HrvMsg
+}
+
+// NewActivityFile converts a decoded file into an ActivityFile. The first
+// data message of the file must be a file_id of type activity, otherwise
+// ErrorNotActivityFile is returned. Messages of other types are skipped.
+func NewActivityFile(f *File) (*ActivityFile, error) {
+	if f == nil {
+		return nil, ErrorNotActivityFile
+	}
+
+	a := new(ActivityFile)
+	first := true
+	for _, record := range f.Records {
+		dm := record.DataMessage
+		if dm == nil {
+			continue
+		}
+
+		if first {
+			first = false
+			if err := a.FileId.Unmarshal(dm); err != nil || a.FileId.Type != FileType_Activity {
+				return nil, ErrorNotActivityFile
+			}
+			continue
+		}
+
+		if err := a.add(dm); err != nil {
+			return nil, err
+		}
+	}
+
+	if first {
+		return nil, ErrorNotActivityFile
+	}
+	return a, nil
+}
+
+func (a *ActivityFile) add(dm *DataMessage) error {
+	switch dm.GlobalMessageType {
+	case GlobalMessageType_FileCreator:
+		msg := new(FileCreatorMsg)
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.FileCreator = msg
+	case GlobalMessageType_Activity:
+		msg := new(ActivityMsg)
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.Activity = msg
+	case GlobalMessageType_Session:
+		var msg SessionMsg
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.Sessions = append(a.Sessions, msg)
+	case GlobalMessageType_Lap:
+		var msg LapMsg
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.Laps = append(a.Laps, msg)
+	case GlobalMessageType_Length:
+		var msg LengthMsg
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.Lengths = append(a.Lengths, msg)
+	case GlobalMessageType_Record:
+		var msg RecordMsg
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.Records = append(a.Records, msg)
+	case GlobalMessageType_Event:
+		var msg EventMsg
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.Events = append(a.Events, msg)
+	case GlobalMessageType_DeviceInfo:
+		var msg DeviceInfoMsg
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.DeviceInfos = append(a.DeviceInfos, msg)
+	case GlobalMessageType_Hrv:
+		var msg HrvMsg
+		if err := msg.Unmarshal(dm); err != nil {
+			return err
+		}
+		a.Hrvs = append(a.Hrvs, msg)
+	}
+	return nil
+}
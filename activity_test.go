@@ -0,0 +1,135 @@
+package fit
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewActivityFile(t *testing.T) {
+	f, err := Decode("testdata/header_14.fit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := NewActivityFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.FileId.Type != FileType_Activity || a.FileId.Manufacturer != Manufacturer_Garmin {
+		t.Errorf("expected a garmin activity, got %v %v", a.FileId.Type, a.FileId.Manufacturer)
+	}
+	if expected := time.Date(1989, time.December, 31, 1, 8, 16, 0, time.UTC); !a.FileId.TimeCreated.Equal(expected) {
+		t.Errorf("expected file created at %v, got %v", expected, a.FileId.TimeCreated)
+	}
+	if a.FileId.SerialNumber != 0 || a.FileId.Product != 0xFFFF {
+		t.Errorf("expected missing fields to be invalid, got %d %d", a.FileId.SerialNumber, a.FileId.Product)
+	}
+
+	if len(a.Records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(a.Records))
+	}
+	for i, expected := range []struct {
+		heartRate uint8
+		timestamp uint32
+	}{
+		{90, 0x1000},
+		{91, 0x1001},
+	} {
+		record := a.Records[i]
+		if record.HeartRate != expected.heartRate || !record.Timestamp.Equal(TimeFromDateTime(expected.timestamp)) {
+			t.Errorf("record %d: expected %d bpm at %d, got %d bpm at %v", i, expected.heartRate, expected.timestamp, record.HeartRate, record.Timestamp)
+		}
+		if !math.IsNaN(record.Speed) || !math.IsNaN(record.PositionLat) {
+			t.Errorf("record %d: expected missing speed and position to be NaN", i)
+		}
+	}
+}
+
+func TestNewActivityFileTypedFields(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 0, [3]byte{0, 1, 0x00}),
+		testDataRecord(0, 4),
+		testDefinitionRecord(ArchitectureLittleEndian, 1, 20, [3]byte{0, 4, 0x85}, [3]byte{1, 4, 0x85}, [3]byte{6, 2, 0x84}, [3]byte{2, 2, 0x84}),
+		testDataRecord(1, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0xC0, 0x8A, 0x0C, 0x28, 0x0A),
+		testDefinitionRecord(ArchitectureLittleEndian, 2, 18, [3]byte{5, 1, 0x00}, [3]byte{6, 1, 0x00}),
+		testDataRecord(2, 2, 7),
+	)
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := NewActivityFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := a.Records[0]
+	if record.PositionLat != 45 || record.PositionLong != -90 {
+		t.Errorf("expected position 45, -90, got %v, %v", record.PositionLat, record.PositionLong)
+	}
+	if record.Speed != 3.21 || record.Altitude != 20 {
+		t.Errorf("expected 3.21 m/s at 20 m, got %v m/s at %v m", record.Speed, record.Altitude)
+	}
+
+	if len(a.Sessions) != 1 || a.Sessions[0].Sport != Sport_Cycling || a.Sessions[0].SubSport != SubSport_Road {
+		t.Errorf("expected a road cycling session, got %+v", a.Sessions)
+	}
+}
+
+func TestNewActivityFileNotActivity(t *testing.T) {
+	for _, data := range [][]byte{
+		testFileBytes(),
+		testFileBytes(
+			testDefinitionRecord(ArchitectureLittleEndian, 0, 0, [3]byte{0, 1, 0x00}),
+			testDataRecord(0, 5),
+		),
+		testFileBytes(
+			testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+			testDataRecord(0, 90),
+		),
+	} {
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewActivityFile(f); err != ErrorNotActivityFile {
+			t.Errorf("expected %v, got %v", ErrorNotActivityFile, err)
+		}
+	}
+}
+
+func TestMessageUnmarshalMismatch(t *testing.T) {
+	var msg RecordMsg
+	if err := msg.Unmarshal(&DataMessage{GlobalMessageType: GlobalMessageType_Lap}); err != ErrorGlobalMessageTypeMismatch {
+		t.Errorf("expected %v, got %v", ErrorGlobalMessageTypeMismatch, err)
+	}
+}
+
+func TestMessageUnmarshalSubfieldScale(t *testing.T) {
+	// Monitoring cycles have a scale of 2, which the steps subfield selected
+	// by walking overrides with 1. The struct field keeps the scale of cycles.
+	for _, activityType := range []byte{6, 0xFF} {
+		data := testFileBytes(
+			testDefinitionRecord(ArchitectureLittleEndian, 0, 55, [3]byte{5, 1, 0x00}, [3]byte{3, 4, 0x86}),
+			testDataRecord(0, activityType, 0xE8, 0x03, 0x00, 0x00),
+		)
+
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+			t.Fatal(err)
+		}
+
+		var msg MonitoringMsg
+		if err := msg.Unmarshal(f.Records[1].DataMessage); err != nil {
+			t.Fatal(err)
+		}
+		if msg.Cycles != 500 {
+			t.Errorf("activity type %d: expected 500 cycles, got %v", activityType, msg.Cycles)
+		}
+	}
+}
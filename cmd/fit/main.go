@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/frankgreco/fit"
+	"github.com/spf13/cobra"
+)
+
+var (
+	config = struct {
+		file        string
+		lenientCRC  bool
+		dropInvalid bool
+		stream      bool
+	}{}
+
+	rootCmd = &cobra.Command{
+		Use: "fit",
+	}
+
+	decodeCmd = &cobra.Command{
+		Use:  "decode",
+		RunE: decode,
+	}
+)
+
+func decode(cmd *cobra.Command, args []string) error {
+	var opts []fit.DecodeOption
+	if config.lenientCRC {
+		opts = append(opts, fit.WithCRCMode(fit.CRCModeWarn))
+	}
+	if config.dropInvalid {
+		opts = append(opts, fit.WithDropInvalidFields())
+	}
+
+	in, err := input()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if config.stream {
+		return stream(in, opts...)
+	}
+
+	chain, err := fit.DecodeChainReader(in, opts...)
+	if err != nil {
+		return err
+	}
+
+	// Chained files are emitted as one JSON document per line.
+	for _, file := range chain {
+		for _, warning := range file.Warnings {
+			fmt.Fprintf(os.Stderr, "warning: %s\n", warning.Error())
+		}
+
+		data, err := json.Marshal(file)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	}
+
+	return nil
+}
+
+// input opens the file to decode, where "-" means stdin.
+func input() (io.ReadCloser, error) {
+	if config.file == "-" {
+		return ioutil.NopCloser(os.Stdin), nil
+	}
+	return os.Open(config.file)
+}
+
+// stream prints every data record as its own JSON document, one per line,
+// as soon as it is decoded.
+func stream(in io.Reader, opts ...fit.DecodeOption) error {
+	r, err := fit.Decompress(in)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	encoder := json.NewEncoder(w)
+
+	// Each decoder stops at the end of its file, so chained files are
+	// decoded by starting a new decoder over the same reader.
+	for {
+		decoder := fit.NewDecoder(r, opts...)
+		if _, err := decoder.Header(); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		for {
+			record, err := decoder.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := encoder.Encode(record); err != nil {
+				return err
+			}
+		}
+
+		for _, warning := range decoder.Warnings() {
+			fmt.Fprintf(os.Stderr, "warning: %s\n", warning.Error())
+		}
+	}
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func init() {
+	decodeCmd.Flags().StringVarP(&config.file, "file", "f", "-", "location of .fit or .fit.gz file, - for stdin")
+	decodeCmd.Flags().BoolVar(&config.lenientCRC, "lenient-crc", false, "warn instead of failing on crc mismatches")
+	decodeCmd.Flags().BoolVar(&config.dropInvalid, "drop-invalid", false, "omit fields holding invalid values")
+	decodeCmd.Flags().BoolVar(&config.stream, "stream", false, "print records as newline delimited json while decoding")
+
+	rootCmd.AddCommand(decodeCmd)
+}
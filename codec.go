@@ -1,6 +1,14 @@
-package main
+package fit
 
-import "fmt"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const unknownPrefix = "unknown_"
 
 func (t GlobalMessageType) MarshalJSON() ([]byte, error) {
 	name, ok := GlobalMessageType_Names[t]
@@ -9,3 +17,70 @@ func (t GlobalMessageType) MarshalJSON() ([]byte, error) {
 	}
 	return []byte(fmt.Sprintf("\"%s\"", name)), nil
 }
+
+// enumString returns the profile name of a value of the named enum type.
+// Values the profile does not name fall back to unknown_ followed by the
+// value, and the invalid sentinel of the base type to invalid.
+func enumString(typ string, v uint64) string {
+	t := ProfileTypes[typ]
+	if name, ok := t.Values[v]; ok {
+		return name
+	}
+	if v == BaseTypeNumber_Infos[t.BaseType].Invalid {
+		return "invalid"
+	}
+	return unknownPrefix + strconv.FormatUint(v, 10)
+}
+
+// enumMarshalJSON writes a value of the named enum type as its profile name,
+// the invalid sentinel as null and any other value as a number.
+func enumMarshalJSON(typ string, v uint64) ([]byte, error) {
+	t := ProfileTypes[typ]
+	if name, ok := t.Values[v]; ok {
+		return json.Marshal(name)
+	}
+	if v == BaseTypeNumber_Infos[t.BaseType].Invalid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(v, 10)), nil
+}
+
+// enumUnmarshalJSON reads a value of the named enum type written by
+// enumMarshalJSON or formatted by enumString.
+func enumUnmarshalJSON(typ string, data []byte) (uint64, error) {
+	t := ProfileTypes[typ]
+	info := BaseTypeNumber_Infos[t.BaseType]
+
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return info.Invalid, nil
+	}
+
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, err
+		}
+		for value, name := range t.Values {
+			if name == s {
+				return value, nil
+			}
+		}
+		switch {
+		case s == "invalid":
+			return info.Invalid, nil
+		case strings.HasPrefix(s, unknownPrefix):
+			s = strings.TrimPrefix(s, unknownPrefix)
+		default:
+			return 0, fmt.Errorf("%w: %s %q", ErrorEnumValueNotDefined, typ, s)
+		}
+	} else {
+		s = string(data)
+	}
+
+	v, err := strconv.ParseUint(s, 10, info.Size*8)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s %s", ErrorEnumValueNotDefined, typ, data)
+	}
+	return v, nil
+}
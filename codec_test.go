@@ -0,0 +1,105 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestEnumString(t *testing.T) {
+	for _, test := range []struct {
+		in  fmt.Stringer
+		out string
+	}{
+		{Sport_Cycling, "cycling"},
+		{SubSport_Road, "road"},
+		{FileType_Activity, "activity"},
+		{Manufacturer_Garmin, "garmin"},
+		{SwimStroke_Breaststroke, "breaststroke"},
+		{LengthType_Active, "active"},
+		{HrType_Irregular, "irregular"},
+		{Sport(200), "unknown_200"},
+		{Sport_Invalid, "invalid"},
+	} {
+		if out := test.in.String(); out != test.out {
+			t.Errorf("expected %s, got %s", test.out, out)
+		}
+	}
+}
+
+func TestEnumFieldJSON(t *testing.T) {
+	// The swim_stroke of a length is named in JSON output like the enums of
+	// activity messages.
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 101, [3]byte{7, 1, 0x00}),
+		testDataRecord(0, 2),
+	)
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(f.Records[1].DataMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `"swim_stroke":{"number":7,"base_type":"enum","value":"breaststroke","raw_value":2`; !strings.Contains(string(out), expected) {
+		t.Errorf("expected %s in %s", expected, out)
+	}
+}
+
+func TestEnumMarshalJSON(t *testing.T) {
+	for _, test := range []struct {
+		in  Sport
+		out string
+	}{
+		{Sport_Cycling, `"cycling"`},
+		{Sport(200), `200`},
+		{Sport_Invalid, `null`},
+	} {
+		out, err := json.Marshal(test.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != test.out {
+			t.Errorf("%d: expected %s, got %s", test.in, test.out, out)
+		}
+
+		var in Sport
+		if err := json.Unmarshal(out, &in); err != nil {
+			t.Fatal(err)
+		}
+		if in != test.in {
+			t.Errorf("%s: expected %d, got %d", out, test.in, in)
+		}
+	}
+}
+
+func TestEnumUnmarshalJSON(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		out Manufacturer
+		err error
+	}{
+		{`"garmin"`, Manufacturer_Garmin, nil},
+		{`"unknown_9999"`, Manufacturer(9999), nil},
+		{`"invalid"`, Manufacturer_Invalid, nil},
+		{`1`, Manufacturer_Garmin, nil},
+		{`"not_a_manufacturer"`, 0, ErrorEnumValueNotDefined},
+		{`70000`, 0, ErrorEnumValueNotDefined},
+		{`-1`, 0, ErrorEnumValueNotDefined},
+	} {
+		var out Manufacturer
+		err := json.Unmarshal([]byte(test.in), &out)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: expected error %v, got %v", test.in, test.err, err)
+			continue
+		}
+		if err == nil && out != test.out {
+			t.Errorf("%s: expected %d, got %d", test.in, test.out, out)
+		}
+	}
+}
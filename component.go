@@ -0,0 +1,159 @@
+package fit
+
+import "math"
+
+// expandComponents resolves the subfields of the message's fields and
+// appends the fields that their components expand into. Expanded fields are
+// resolved and expanded in turn. A destination field that is already present
+// in the message is left as it was decoded.
+func (m *DataMessage) expandComponents(profile *ProfileMessage) {
+	for i := 0; i < len(m.Fields); i++ {
+		pf, ok := profile.Fields[m.Fields[i].Number]
+		if !ok {
+			continue
+		}
+
+		components := pf.Components
+		if sf := m.subfield(pf); sf != nil {
+			m.Fields[i].applySubfield(sf)
+			components = sf.Components
+		}
+		if len(components) == 0 {
+			continue
+		}
+
+		field := m.Fields[i]
+		m.expand(&field, components, profile)
+	}
+}
+
+// subfield returns the first subfield of pf whose reference field holds a
+// referenced value, or nil when the field keeps its own interpretation.
+func (m *DataMessage) subfield(pf *ProfileField) *ProfileSubfield {
+	for i := range pf.Subfields {
+		for _, ref := range pf.Subfields[i].References {
+			field, ok := m.Field(ref.Number)
+			if !ok {
+				continue
+			}
+			if v, ok := field.Uint64(); ok && v == ref.Value {
+				return &pf.Subfields[i]
+			}
+		}
+	}
+	return nil
+}
+
+// expand appends a field for each component of f whose destination field
+// is missing from the message.
+func (m *DataMessage) expand(f *Field, components []ProfileComponent, profile *ProfileMessage) {
+	unpackComponents(f, components, func(c ProfileComponent, raw uint64) {
+		dest, ok := profile.Fields[c.Number]
+		if !ok {
+			return
+		}
+		if _, ok := m.Field(c.Number); ok {
+			return
+		}
+		m.Fields = append(m.Fields, componentField(dest, c, raw))
+	})
+}
+
+// unpackComponents calls fn with the raw bits of each component of f, taking
+// the bits of each component in turn starting from the least significant bit
+// of f. Invalid fields have no components.
+func unpackComponents(f *Field, components []ProfileComponent, fn func(c ProfileComponent, raw uint64)) {
+	if f.Invalid {
+		return
+	}
+
+	bits, n := fieldBits(f)
+	for _, c := range components {
+		if c.Bits > n {
+			return
+		}
+		raw := bits & (1<<c.Bits - 1)
+		bits >>= c.Bits
+		n -= c.Bits
+		fn(c, raw)
+	}
+}
+
+// componentField converts the raw bits of a component to a field holding
+// the same value in the scale and offset of its destination field.
+func componentField(dest *ProfileField, c ProfileComponent, raw uint64) Field {
+	field := Field{
+		Number:   dest.Number,
+		BaseType: dest.BaseType,
+		Expanded: true,
+	}
+	field.applyProfile(dest)
+
+	value := float64(raw)
+	if c.Scale != 0 {
+		value /= c.Scale
+	}
+	value -= c.Offset
+
+	value += dest.Offset
+	if dest.Scale != 0 {
+		value *= dest.Scale
+	}
+
+	switch BaseTypeNumber_Infos[dest.BaseType].Kind {
+	case BaseTypeKind_Float:
+		field.Value = value
+	case BaseTypeKind_Signed:
+		field.Value = int64(math.Round(value))
+	default:
+		field.Value = uint64(math.Round(value))
+	}
+	return field
+}
+
+// componentRaw converts the value of f back to the raw bits of a component
+// expanding into it.
+func componentRaw(f *Field, c ProfileComponent) (uint64, bool) {
+	value, ok := f.Scaled()
+	if !ok {
+		return 0, false
+	}
+
+	value += c.Offset
+	if c.Scale != 0 {
+		value *= c.Scale
+	}
+	if value < 0 {
+		return 0, false
+	}
+	return uint64(math.Round(value)), true
+}
+
+// fieldBits returns the raw bits of a field holding packed components,
+// reading byte and array fields as a little endian bit stream, and the
+// number of bits available.
+func fieldBits(f *Field) (uint64, uint8) {
+	size := BaseTypeNumber_Infos[f.BaseType].Size
+
+	switch v := f.Value.(type) {
+	case uint64:
+		return v, uint8(size * 8)
+	case int64:
+		return uint64(v) & (1<<(uint(size)*8) - 1), uint8(size * 8)
+	case []byte:
+		return packBits(len(v), 1, func(i int) uint64 { return uint64(v[i]) })
+	case []uint64:
+		return packBits(len(v), size, func(i int) uint64 { return v[i] })
+	}
+	return 0, 0
+}
+
+func packBits(n, size int, element func(int) uint64) (uint64, uint8) {
+	var bits uint64
+	var available uint
+	for i := 0; i < n && available+uint(size)*8 <= 64; i++ {
+		bits |= element(i) << available
+		available += uint(size) * 8
+	}
+	return bits, uint8(available)
+}
@@ -0,0 +1,203 @@
+package fit
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestExpandComponents(t *testing.T) {
+	type expected struct {
+		name   string
+		raw    interface{}
+		scaled float64
+	}
+
+	for _, test := range []struct {
+		name     string
+		data     []byte
+		expected []expected
+		missing  []string
+	}{
+		{
+			// 3.21 m/s and 100 m packed as 12 bit components of a byte array.
+			name: "compressed speed and distance",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{8, 3, 0x0D}),
+				testDataRecord(0, 0x41, 0x01, 0x64),
+			),
+			expected: []expected{
+				{"speed", uint64(3210), 3.21},
+				{"distance", uint64(10000), 100},
+				{"enhanced_speed", uint64(3210), 3.21},
+			},
+		},
+		{
+			name: "single component",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 21, [3]byte{2, 2, 0x84}),
+				testDataRecord(0, 0x34, 0x12),
+			),
+			expected: []expected{
+				{"data16", uint64(0x1234), 0x1234},
+				{"data", uint64(0x1234), 0x1234},
+			},
+		},
+		{
+			// The intensity component is unscaled while the intensity
+			// field has a scale of 10.
+			name: "components of a byte",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 55, [3]byte{24, 1, 0x0D}),
+				testDataRecord(0, 0x61),
+			),
+			expected: []expected{
+				{"activity_type", uint64(1), 1},
+				{"intensity", uint64(30), 3},
+			},
+		},
+		{
+			name: "destination present",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{6, 2, 0x84}, [3]byte{73, 4, 0x86}),
+				testDataRecord(0, 0x8A, 0x0C, 0x10, 0x27, 0x00, 0x00),
+			),
+			expected: []expected{
+				{"speed", uint64(3210), 3.21},
+				{"enhanced_speed", uint64(10000), 10},
+			},
+		},
+		{
+			name: "invalid source",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{6, 2, 0x84}),
+				testDataRecord(0, 0xFF, 0xFF),
+			),
+			missing: []string{"enhanced_speed"},
+		},
+	} {
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(test.data)); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		dm := f.Records[1].DataMessage
+
+		for _, e := range test.expected {
+			field, ok := dm.FieldByName(e.name)
+			if !ok {
+				t.Errorf("%s: expected field %s", test.name, e.name)
+				continue
+			}
+			if field.Value != e.raw {
+				t.Errorf("%s: %s: expected raw value %v, got %v", test.name, e.name, e.raw, field.Value)
+			}
+			if v, ok := field.Scaled(); !ok || v != e.scaled {
+				t.Errorf("%s: %s: expected %v, got %v", test.name, e.name, e.scaled, v)
+			}
+		}
+		for _, name := range test.missing {
+			if _, ok := dm.FieldByName(name); ok {
+				t.Errorf("%s: unexpected field %s", test.name, name)
+			}
+		}
+	}
+}
+
+func TestSubfields(t *testing.T) {
+	type expected struct {
+		name   string
+		typ    string
+		scaled float64
+		units  string
+	}
+
+	for _, test := range []struct {
+		name     string
+		data     []byte
+		expected []expected
+		missing  []string
+	}{
+		{
+			name: "reference matches",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 23, [3]byte{2, 2, 0x84}, [3]byte{4, 2, 0x84}),
+				testDataRecord(0, 0x01, 0x00, 0x0E, 0x0A),
+			),
+			expected: []expected{
+				{"garmin_product", "garmin_product", 2574, ""},
+			},
+			missing: []string{"product"},
+		},
+		{
+			name: "reference does not match",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 23, [3]byte{2, 2, 0x84}, [3]byte{4, 2, 0x84}),
+				testDataRecord(0, 0xFE, 0x00, 0x0E, 0x0A),
+			),
+			expected: []expected{
+				{"product", "uint16", 2574, ""},
+			},
+			missing: []string{"garmin_product", "favero_product"},
+		},
+		{
+			name: "scaled subfield",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 27, [3]byte{1, 1, 0x00}, [3]byte{2, 4, 0x86}),
+				testDataRecord(0, 0x00, 0x60, 0xEA, 0x00, 0x00),
+			),
+			expected: []expected{
+				{"duration_time", "uint32", 60, "s"},
+			},
+			missing: []string{"duration_value"},
+		},
+		{
+			name: "subfield components",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 21, [3]byte{0, 1, 0x00}, [3]byte{3, 4, 0x86}),
+				testDataRecord(0, 43, 0x01, 0x02, 0x03, 0x04),
+			),
+			expected: []expected{
+				{"gear_change_data", "uint32", 0x04030201, ""},
+				{"rear_gear_num", "uint8z", 1, ""},
+				{"rear_gear", "uint8z", 2, ""},
+				{"front_gear_num", "uint8z", 3, ""},
+				{"front_gear", "uint8z", 4, ""},
+			},
+			missing: []string{"data"},
+		},
+		{
+			name: "expanded field subfield",
+			data: testFileBytes(
+				testDefinitionRecord(ArchitectureLittleEndian, 0, 21, [3]byte{0, 1, 0x00}, [3]byte{2, 2, 0x84}),
+				testDataRecord(0, 42, 0x05, 0x0B),
+			),
+			expected: []expected{
+				{"gear_change_data", "uint32", 0x0B05, ""},
+				{"rear_gear_num", "uint8z", 5, ""},
+				{"rear_gear", "uint8z", 11, ""},
+			},
+			missing: []string{"data"},
+		},
+	} {
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(test.data)); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		dm := f.Records[1].DataMessage
+
+		for _, e := range test.expected {
+			field, ok := dm.FieldByName(e.name)
+			if !ok {
+				t.Errorf("%s: expected field %s", test.name, e.name)
+				continue
+			}
+			if v, ok := field.Scaled(); !ok || v != e.scaled || field.Type != e.typ || field.Units != e.units {
+				t.Errorf("%s: %s: expected %v %s of type %s, got %v %s of type %s", test.name, e.name, e.scaled, e.units, e.typ, v, field.Units, field.Type)
+			}
+		}
+		for _, name := range test.missing {
+			if _, ok := dm.FieldByName(name); ok {
+				t.Errorf("%s: unexpected field %s", test.name, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package fit
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// DateTimeMin is the smallest date_time or local_date_time holding an
+	// absolute time. Smaller values are system time, the seconds since the
+	// device powered on.
+	DateTimeMin = 0x10000000
+
+	// DateTimeInvalid is the invalid value of date_time and local_date_time
+	// fields, which DateTimeFromTime returns for times it cannot represent.
+	DateTimeInvalid = 0xFFFFFFFF
+
+	// SemicirclesInvalid is the invalid value of position fields, which
+	// SemicirclesFromDegrees returns for NaN and infinite degrees.
+	SemicirclesInvalid = 0x7FFFFFFF
+)
+
+const (
+	typeNameDateTime      = "date_time"
+	typeNameLocalDateTime = "local_date_time"
+
+	unitsSemicircles = "semicircles"
+	unitsDegrees     = "degrees"
+
+	// localDateTimeLayout formats local_date_time values, which have no
+	// time zone, as RFC 3339 without the offset.
+	localDateTimeLayout = "2006-01-02T15:04:05"
+)
+
+// semicirclesPerDegree converts between degrees and the semicircles FIT
+// stores positions in, where 2^31 semicircles make 180 degrees.
+const semicirclesPerDegree = (1 << 31) / 180.0
+
+// fitEpoch is the zero of date_time values, 1989-12-31 00:00:00 UTC.
+var fitEpoch = time.Date(1989, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// TimeFromDateTime converts a date_time, in seconds since the FIT epoch of
+// 1989-12-31 00:00:00 UTC, to a time in UTC. Values below DateTimeMin are
+// system time rather than absolute time, see IsSystemTime.
+func TimeFromDateTime(v uint32) time.Time {
+	return fitEpoch.Add(time.Duration(v) * time.Second)
+}
+
+// DateTimeFromTime converts a time to a date_time, dropping fractions of a
+// second. Times before the FIT epoch or too late for 32 bits are converted
+// to DateTimeInvalid.
+func DateTimeFromTime(t time.Time) uint32 {
+	if t.Before(fitEpoch) {
+		return DateTimeInvalid
+	}
+	seconds := t.Unix() - fitEpoch.Unix()
+	if seconds >= DateTimeInvalid {
+		return DateTimeInvalid
+	}
+	return uint32(seconds)
+}
+
+// TimeFromLocalDateTime converts a local_date_time, which counts the seconds
+// since 1989-12-31 00:00:00 on the wall clock of the device without saying
+// which time zone that is, to the same wall clock time in loc.
+func TimeFromLocalDateTime(v uint32, loc *time.Location) time.Time {
+	t := TimeFromDateTime(v)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+// LocalDateTimeFromTime converts the wall clock of a time, in its own
+// location, to a local_date_time.
+func LocalDateTimeFromTime(t time.Time) uint32 {
+	return DateTimeFromTime(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC))
+}
+
+// IsSystemTime reports whether a date_time or local_date_time holds system
+// time, the seconds since the device powered on, rather than absolute time.
+func IsSystemTime(v uint32) bool {
+	return v < DateTimeMin
+}
+
+// DegreesFromSemicircles converts a position in semicircles to degrees.
+func DegreesFromSemicircles(v int32) float64 {
+	return float64(v) / semicirclesPerDegree
+}
+
+// SemicirclesFromDegrees converts a position in degrees to the nearest
+// semicircle. Degrees outside -180 to 180 wrap around, so 180 degrees
+// becomes -180 degrees.
+func SemicirclesFromDegrees(d float64) int32 {
+	if math.IsNaN(d) || math.IsInf(d, 0) {
+		return SemicirclesInvalid
+	}
+	d = math.Mod(d, 360)
+	return int32(int64(math.Round(d * semicirclesPerDegree)))
+}
@@ -0,0 +1,103 @@
+package fit
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTimeFromDateTime(t *testing.T) {
+	for _, test := range []struct {
+		in     uint32
+		out    time.Time
+		system bool
+	}{
+		{0, time.Date(1989, time.December, 31, 0, 0, 0, 0, time.UTC), true},
+		{DateTimeMin - 1, time.Date(1998, time.July, 3, 21, 24, 15, 0, time.UTC), true},
+		{DateTimeMin, time.Date(1998, time.July, 3, 21, 24, 16, 0, time.UTC), false},
+		{1000000000, time.Date(2021, time.September, 8, 1, 46, 40, 0, time.UTC), false},
+		{DateTimeInvalid - 1, time.Date(2126, time.February, 6, 6, 28, 14, 0, time.UTC), false},
+	} {
+		out := TimeFromDateTime(test.in)
+		if !out.Equal(test.out) || out.Location() != time.UTC {
+			t.Errorf("%#x: expected %v, got %v", test.in, test.out, out)
+		}
+		if system := IsSystemTime(test.in); system != test.system {
+			t.Errorf("%#x: expected system time %t, got %t", test.in, test.system, system)
+		}
+		if in := DateTimeFromTime(out); in != test.in {
+			t.Errorf("%v: expected %#x, got %#x", out, test.in, in)
+		}
+	}
+}
+
+func TestDateTimeFromTime(t *testing.T) {
+	for _, test := range []struct {
+		in  time.Time
+		out uint32
+	}{
+		{time.Date(1989, time.December, 31, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(1989, time.December, 30, 23, 59, 59, 0, time.UTC), DateTimeInvalid},
+		{time.Date(1989, time.December, 31, 0, 0, 1, 999999999, time.UTC), 1},
+		{time.Date(1989, time.December, 31, 1, 0, 0, 0, time.FixedZone("CET", 3600)), 0},
+		{time.Date(2126, time.February, 6, 6, 28, 15, 0, time.UTC), DateTimeInvalid},
+		{time.Time{}, DateTimeInvalid},
+	} {
+		if out := DateTimeFromTime(test.in); out != test.out {
+			t.Errorf("%v: expected %#x, got %#x", test.in, test.out, out)
+		}
+	}
+}
+
+func TestTimeFromLocalDateTime(t *testing.T) {
+	loc := time.FixedZone("PDT", -7*3600)
+
+	out := TimeFromLocalDateTime(1000000000, loc)
+	expected := time.Date(2021, time.September, 8, 1, 46, 40, 0, loc)
+	if !out.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, out)
+	}
+	if in := LocalDateTimeFromTime(out); in != 1000000000 {
+		t.Errorf("%v: expected %d, got %d", out, 1000000000, in)
+	}
+	if in := LocalDateTimeFromTime(time.Date(1989, time.December, 30, 23, 0, 0, 0, loc)); in != DateTimeInvalid {
+		t.Errorf("expected %#x, got %#x", uint32(DateTimeInvalid), in)
+	}
+}
+
+func TestDegreesFromSemicircles(t *testing.T) {
+	for _, test := range []struct {
+		semicircles int32
+		degrees     float64
+	}{
+		{0, 0},
+		{1 << 30, 90},
+		{-1 << 30, -90},
+		{536870912, 45},
+		{math.MinInt32, -180},
+	} {
+		if degrees := DegreesFromSemicircles(test.semicircles); degrees != test.degrees {
+			t.Errorf("%d: expected %v, got %v", test.semicircles, test.degrees, degrees)
+		}
+		if semicircles := SemicirclesFromDegrees(test.degrees); semicircles != test.semicircles {
+			t.Errorf("%v: expected %d, got %d", test.degrees, test.semicircles, semicircles)
+		}
+	}
+}
+
+func TestSemicirclesFromDegrees(t *testing.T) {
+	for _, test := range []struct {
+		degrees     float64
+		semicircles int32
+	}{
+		{180, math.MinInt32},
+		{370, 1 << 30 / 9},
+		{-370, -1 << 30 / 9},
+		{math.NaN(), SemicirclesInvalid},
+		{math.Inf(1), SemicirclesInvalid},
+	} {
+		if semicircles := SemicirclesFromDegrees(test.degrees); semicircles != test.semicircles {
+			t.Errorf("%v: expected %d, got %d", test.degrees, test.semicircles, semicircles)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package fit
+
+import (
+	"fmt"
+	"hash"
+)
+
+// CRCSize is the size, in bytes, of a FIT CRC-16 checksum.
+const CRCSize = 2
+
+var crcTable = [16]uint16{
+	0x0000, 0xCC01, 0xD801, 0x1400, 0xF001, 0x3C00, 0x2800, 0xE401,
+	0xA001, 0x6C00, 0x7800, 0xB401, 0x5000, 0x9C01, 0x8801, 0x4400,
+}
+
+// CRC16 computes the CRC-16 checksum used by the FIT protocol to protect the
+// file header and the file as a whole. It implements hash.Hash.
+type CRC16 struct {
+	crc uint16
+}
+
+var _ hash.Hash = (*CRC16)(nil)
+
+// NewCRC16 returns a new CRC16 with a zero initial value.
+func NewCRC16() *CRC16 {
+	return new(CRC16)
+}
+
+// UpdateCRC16 returns the result of adding the bytes in p to crc.
+func UpdateCRC16(crc uint16, p []byte) uint16 {
+	for _, b := range p {
+		tmp := crcTable[crc&0xF]
+		crc = (crc >> 4) & 0x0FFF
+		crc = crc ^ tmp ^ crcTable[b&0xF]
+
+		tmp = crcTable[crc&0xF]
+		crc = (crc >> 4) & 0x0FFF
+		crc = crc ^ tmp ^ crcTable[(b>>4)&0xF]
+	}
+	return crc
+}
+
+// ChecksumCRC16 returns the FIT CRC-16 checksum of data.
+func ChecksumCRC16(data []byte) uint16 {
+	return UpdateCRC16(0, data)
+}
+
+func (c *CRC16) Write(p []byte) (int, error) {
+	c.crc = UpdateCRC16(c.crc, p)
+	return len(p), nil
+}
+
+// Sum16 returns the checksum of all data written so far.
+func (c *CRC16) Sum16() uint16 {
+	return c.crc
+}
+
+// Sum appends the little-endian checksum to b, matching how FIT stores it.
+func (c *CRC16) Sum(b []byte) []byte {
+	return append(b, byte(c.crc), byte(c.crc>>8))
+}
+
+func (c *CRC16) Reset() {
+	c.crc = 0
+}
+
+func (c *CRC16) Size() int {
+	return CRCSize
+}
+
+func (c *CRC16) BlockSize() int {
+	return 1
+}
+
+// ErrCRCMismatch is returned when a stored CRC does not match the CRC
+// computed over the bytes it protects.
+type ErrCRCMismatch struct {
+	Scope    CRCScope
+	Expected uint16
+	Actual   uint16
+}
+
+func (e *ErrCRCMismatch) Error() string {
+	return fmt.Sprintf("%s crc mismatch: expected 0x%04X, got 0x%04X", e.Scope, e.Expected, e.Actual)
+}
+
+// CRCScope identifies which part of a file a CRC protects.
+type CRCScope string
+
+const (
+	CRCScopeHeader CRCScope = "header"
+	CRCScopeFile   CRCScope = "file"
+)
@@ -0,0 +1,48 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestChecksumCRC16(t *testing.T) {
+	for _, test := range []struct {
+		in  []byte
+		out uint16
+	}{
+		{nil, 0x0000},
+		{[]byte("123456789"), 0xBB3D},
+	} {
+		if out := ChecksumCRC16(test.in); out != test.out {
+			t.Errorf("%v: expected 0x%04X, got 0x%04X", test.in, test.out, out)
+		}
+	}
+}
+
+func TestCRC16Write(t *testing.T) {
+	data := []byte("123456789")
+
+	crc := NewCRC16()
+	for i := range data {
+		crc.Write(data[i : i+1])
+	}
+	if crc.Sum16() != ChecksumCRC16(data) {
+		t.Errorf("expected 0x%04X, got 0x%04X", ChecksumCRC16(data), crc.Sum16())
+	}
+
+	sum := crc.Sum(nil)
+	if !bytes.Equal(sum, []byte{0x3D, 0xBB}) || binary.LittleEndian.Uint16(sum) != crc.Sum16() {
+		t.Errorf("expected little endian checksum, got %v", sum)
+	}
+
+	// A checksum followed by its own little endian encoding has a residue of zero.
+	if residue := ChecksumCRC16(append(data, sum...)); residue != 0 {
+		t.Errorf("expected zero residue, got 0x%04X", residue)
+	}
+
+	crc.Reset()
+	if crc.Sum16() != 0 {
+		t.Errorf("expected reset checksum to be zero, got 0x%04X", crc.Sum16())
+	}
+}
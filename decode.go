@@ -0,0 +1,728 @@
+package fit
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MinimumHeaderSize = 12
+	MaximumHeaderSize = 14
+
+	// Deprecated: MaximumeaderSize is a misspelling kept for compatibility.
+	// Use MaximumHeaderSize.
+	MaximumeaderSize = MaximumHeaderSize
+
+	MaxLocalMessageTypes = 16
+
+	ArchitectureLittleEndian = 0
+	ArchitectureBigEndian    = 1
+
+	FieldNumberTimestamp = 253
+
+	compressedTimestampMask = 0x1F
+)
+
+func (t *DataRecordMessageType) MarshalJSON() ([]byte, error) {
+	if t == nil {
+		return nil, errors.New("data record message type must be defined")
+	}
+
+	switch *t {
+	case DataRecordMessageType_Definition:
+		return json.Marshal("DEFINITION")
+	case DataRecordMessageType_Data:
+		return json.Marshal("DATA")
+	default:
+		return nil, fmt.Errorf("unknown data record message type %d", *t)
+	}
+}
+
+func (t *DataRecordHeaderType) MarshalJSON() ([]byte, error) {
+	if t == nil {
+		return nil, errors.New("data record header type must be defined")
+	}
+
+	switch *t {
+	case DataRecordHeaderType_Normal:
+		return json.Marshal("NORMAL")
+	case DataRecordHeaderType_CompressedTimestamp:
+		return json.Marshal("COMPRESSED_TIMESTAMP")
+	default:
+		return nil, fmt.Errorf("unknown data record header type %d", *t)
+	}
+}
+
+func (h *DataRecordHeader) Unmarshal(data []byte) error {
+	if data == nil || len(data) != 1 {
+		return errors.New("a data record header must be exactly 1 byte")
+	}
+	exploded := explodeByte(uint8(data[0]))
+	if len(exploded) != 8 {
+		return errors.New("bit represention of a byte must be of length 8")
+	}
+
+	isNormalHeader, err := strconv.ParseInt(string(exploded[0:1]), 2, 8)
+	if err != nil {
+		return err
+	}
+	if isNormalHeader == 0 {
+		h.Type = DataRecordHeaderType_Normal
+		localMessageType, err := strconv.ParseInt(string(exploded[4:8]), 2, 8)
+		if err != nil {
+			return err
+		}
+		h.LocalMessageType = uint8(localMessageType)
+		messageType, err := strconv.ParseInt(string(exploded[1:2]), 2, 8)
+		if err != nil {
+			return err
+		}
+		if messageType == 1 {
+			h.MessageType = DataRecordMessageType_Definition
+		} else if messageType == 0 {
+			h.MessageType = DataRecordMessageType_Data
+		}
+		developerData, err := strconv.ParseInt(string(exploded[2:3]), 2, 8)
+		if err != nil {
+			return err
+		}
+		if developerData == 1 {
+			h.DeveloperData = true
+		}
+	} else if isNormalHeader == 1 {
+		h.Type = DataRecordHeaderType_CompressedTimestamp
+		h.MessageType = DataRecordMessageType_Data
+		timeOffset, err := strconv.ParseInt(string(exploded[3:8]), 2, 8)
+		if err != nil {
+			return err
+		}
+		h.TimeOffset = uint8(timeOffset)
+		localMessageType, err := strconv.ParseInt(string(exploded[1:3]), 2, 8)
+		if err != nil {
+			return err
+		}
+		h.LocalMessageType = uint8(localMessageType)
+	}
+
+	return nil
+}
+
+// ByteOrder returns the byte order that multi-byte values described by this
+// definition are encoded in.
+func (m *DefinitionMessage) ByteOrder() binary.ByteOrder {
+	if m.Architecture == ArchitectureBigEndian {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
+func (m *DefinitionMessage) DataMessageSize() int64 {
+	size := int64(0)
+
+	for _, feildDefinition := range m.Fields {
+		size += int64(feildDefinition.Size)
+	}
+
+	return size
+}
+
+func (m *DataMessage) Unmarshal(def *DefinitionMessage, data []byte) error {
+	if m == nil || def == nil || int64(len(data)) != def.DataMessageSize() {
+		return ErrorMalformedBuffer
+	}
+
+	m.GlobalMessageType = def.GlobalMessageType
+	m.Fields = []Field{}
+	m.definition = def
+	m.DeveloperFields = [][]byte{}
+
+	byteOrder := def.ByteOrder()
+	profile := ProfileMessages[def.GlobalMessageType]
+
+	offset := 0
+	for i := 0; i < int(def.NumFields); i++ {
+		fieldData := data[offset : offset+int(def.Fields[i].Size)]
+		offset += int(def.Fields[i].Size)
+
+		// Is this is a developer field?
+		if def.Fields[i].BaseType == nil {
+			m.DeveloperFields = append(m.DeveloperFields, fieldData)
+			continue
+		}
+
+		var field Field
+		if err := field.Unmarshal(def.Fields[i], byteOrder, fieldData); err != nil {
+			return err
+		}
+		if profile != nil {
+			if pf, ok := profile.Fields[field.Number]; ok {
+				field.applyProfile(pf)
+			}
+		}
+		m.Fields = append(m.Fields, field)
+	}
+
+	if profile != nil {
+		m.expandComponents(profile)
+	}
+
+	return nil
+}
+
+// MarshalJSON writes the normal fields as an object keyed by field name, in
+// the order they were defined.
+func (m *DataMessage) MarshalJSON() ([]byte, error) {
+	var fields bytes.Buffer
+	fields.WriteByte('{')
+	for i := range m.Fields {
+		if i > 0 {
+			fields.WriteByte(',')
+		}
+		key, err := json.Marshal(m.Fields[i].Key())
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.Fields[i])
+		if err != nil {
+			return nil, err
+		}
+		fields.Write(key)
+		fields.WriteByte(':')
+		fields.Write(value)
+	}
+	fields.WriteByte('}')
+
+	// Absolute timestamps are written as times, system time as seconds.
+	var timestamp interface{}
+	if m.Timestamp != nil {
+		timestamp = *m.Timestamp
+		if !IsSystemTime(*m.Timestamp) {
+			timestamp = TimeFromDateTime(*m.Timestamp).Format(time.RFC3339)
+		}
+	}
+
+	return json.Marshal(struct {
+		GlobalMessageType GlobalMessageType `json:"global_message_type"`
+		Fields            json.RawMessage   `json:"fields"`
+		DeveloperFields   [][]byte          `json:"developer_fields"`
+		Timestamp         interface{}       `json:"timestamp,omitempty"`
+	}{
+		GlobalMessageType: m.GlobalMessageType,
+		Fields:            fields.Bytes(),
+		DeveloperFields:   m.DeveloperFields,
+		Timestamp:         timestamp,
+	})
+}
+
+// Field returns the normal field with the given field number.
+func (m *DataMessage) Field(number uint8) (*Field, bool) {
+	if m == nil {
+		return nil, false
+	}
+
+	for i := range m.Fields {
+		if m.Fields[i].Number == number {
+			return &m.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// FieldByName returns the normal field with the given profile name.
+func (m *DataMessage) FieldByName(name string) (*Field, bool) {
+	if m == nil {
+		return nil, false
+	}
+
+	for i := range m.Fields {
+		if m.Fields[i].Name == name {
+			return &m.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// DropInvalidFields removes every field that holds its invalid sentinel.
+func (m *DataMessage) DropInvalidFields() {
+	if m == nil {
+		return
+	}
+
+	fields := m.Fields[:0]
+	for _, field := range m.Fields {
+		if !field.Invalid {
+			fields = append(fields, field)
+		}
+	}
+	m.Fields = fields
+}
+
+// timestampResolver turns the 5 bit time offset of compressed timestamp
+// headers into absolute timestamps, relative to the last full timestamp seen.
+type timestampResolver struct {
+	last  uint32
+	valid bool
+}
+
+func (t *timestampResolver) Resolve(h *DataRecordHeader, dm *DataMessage) {
+	if h.Type == DataRecordHeaderType_CompressedTimestamp {
+		// Without a preceding full timestamp there is nothing to offset from.
+		if !t.valid {
+			return
+		}
+
+		offset := uint32(h.TimeOffset)
+		timestamp := (t.last &^ compressedTimestampMask) + offset
+		if offset < t.last&compressedTimestampMask {
+			// The offset rolled over.
+			timestamp += compressedTimestampMask + 1
+		}
+
+		t.last = timestamp
+		dm.Timestamp = &timestamp
+		return
+	}
+
+	field, _ := dm.Field(FieldNumberTimestamp)
+	if value, ok := field.Uint64(); ok {
+		timestamp := uint32(value)
+		t.last = timestamp
+		t.valid = true
+		dm.Timestamp = &timestamp
+	}
+}
+
+func (h *FileHeader) Unmarshal(data []byte) error {
+	if data == nil || len(data) < 1 {
+		return errors.New("data must be defined")
+	}
+
+	h.Size = data[0]
+	if h.Size != MinimumHeaderSize && h.Size != MaximumHeaderSize {
+		return fmt.Errorf("valid header sizes are %d and %d", MinimumHeaderSize, MaximumHeaderSize)
+	}
+	if uint8(len(data)) != h.Size {
+		return fmt.Errorf("data must be at least of size %d", h.Size)
+	}
+
+	// Header fields are always little endian, regardless of the
+	// architecture of the messages that follow.
+	h.ProtocolVersion = data[1]
+	h.ProfileVersion = binary.LittleEndian.Uint16(data[2:4])
+	h.DataSize = binary.LittleEndian.Uint32(data[4:8])
+	h.DataType = string(data[8:12])
+	if h.Size == MaximumHeaderSize {
+		h.CRC = binary.LittleEndian.Uint16(data[12:14])
+
+		// A header CRC of zero means the encoder chose not to compute one.
+		h.HasCRC = h.CRC != 0
+		if actual := ChecksumCRC16(data[:MinimumHeaderSize]); h.HasCRC && h.CRC != actual {
+			return &ErrCRCMismatch{
+				Scope:    CRCScopeHeader,
+				Expected: h.CRC,
+				Actual:   actual,
+			}
+		}
+	}
+	return nil
+}
+
+// readFull reads exactly len(buf) bytes from r. It is used part way through
+// a structure, where running out of input always means it was truncated.
+func readFull(r io.Reader, buf []byte) (int, error) {
+	n, err := io.ReadFull(r, buf)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func explodeByte(data uint8) string {
+	var b strings.Builder
+
+	for i := 7; i >= 0; i-- {
+		if (data & (1 << uint(i))) != 0 {
+			b.WriteString("1")
+		} else {
+			b.WriteString("0")
+		}
+	}
+
+	return b.String()
+}
+
+func NewDecoderState() *DecoderState {
+	return new(DecoderState)
+}
+
+func (d *localDefinitions) Set(localMessageType uint8, def *DefinitionMessage) error {
+	if d == nil {
+		return ErrorTypeNotDefined
+	}
+	if int(localMessageType) >= MaxLocalMessageTypes {
+		return fmt.Errorf("%w: %d", ErrorLocalMessageTypeOutOfRange, localMessageType)
+	}
+
+	// A later definition for the same local message type replaces the
+	// earlier one for all subsequent data messages.
+	d[localMessageType] = def
+	return nil
+}
+
+func (d *localDefinitions) Get(localMessageType uint8) (*DefinitionMessage, error) {
+	if d == nil {
+		return nil, ErrorTypeNotDefined
+	}
+	if int(localMessageType) >= MaxLocalMessageTypes {
+		return nil, fmt.Errorf("%w: %d", ErrorLocalMessageTypeOutOfRange, localMessageType)
+	}
+
+	def := d[localMessageType]
+	if def == nil {
+		return nil, fmt.Errorf("%w: %d", ErrorLocalMessageTypeNotDefined, localMessageType)
+	}
+	return def, nil
+}
+
+func (dm *DataMessage) ReadAndUnmarshal(ctx context.Context, def *DefinitionMessage, r io.Reader) (int, error) {
+	if dm == nil {
+		return 0, errors.New("data message is nil")
+	}
+	if def == nil {
+		return 0, errors.New("definition message must preceed a data message")
+	}
+
+	var totalBytesRead int
+
+	buf := make([]byte, def.DataMessageSize())
+	n, err := readFull(r, buf)
+	totalBytesRead += n
+	if err != nil {
+		return totalBytesRead, err
+	}
+
+	if err := dm.Unmarshal(def, buf); err != nil {
+		return totalBytesRead, err
+	}
+
+	return totalBytesRead, nil
+}
+
+func (f *FieldDefinitions) Unmarshal(fieldType DataRecordFieldType, data []byte) error {
+	// Each field is exactly 3 bytes.
+	numFields := len(data) / 3
+	newFields := make([]FieldDefinition, numFields)
+
+	for i := 0; i < (numFields * 3); i += 3 {
+		newFields[i/3] = FieldDefinition{
+			Type:   fieldType,
+			Number: uint8(data[i]),
+			Size:   uint8(data[i+1]),
+		}
+
+		if fieldType == DataRecordFieldType_Developer {
+			newFields[i/3].DeveloperDataIndex = uint8(data[i+2])
+			continue
+		}
+
+		baseTypeExploded := explodeByte(uint8(data[i+2]))
+
+		baseTypeNumber, err := strconv.ParseInt(string(baseTypeExploded[3:8]), 2, 8)
+		if err != nil {
+			return err
+		}
+
+		endianAbility, err := strconv.ParseInt(string(baseTypeExploded[0:1]), 2, 8)
+		if err != nil {
+			return err
+		}
+
+		newFields[i/3].BaseType = &BaseType{
+			Number:        uint8(baseTypeNumber),
+			EndianAbility: uint8(endianAbility),
+		}
+	}
+
+	*f = append(*f, newFields...)
+
+	return nil
+}
+
+func (dm *DefinitionMessage) ReadAndUnmarshal(ctx context.Context, h *DataRecordHeader, r io.Reader) (int, error) {
+	var totalBytesRead int
+
+	if dm == nil || h == nil {
+		return 0, ErrorTypeNotDefined
+	}
+
+	fixedContentBuffer := make([]byte, 5)
+	fixedContentBytesRead, err := readFull(r, fixedContentBuffer)
+	totalBytesRead += fixedContentBytesRead
+	if err != nil {
+		return totalBytesRead, err
+	}
+
+	// This does not yet include developer field definitions.
+	dm.NumFields = fixedContentBuffer[4]
+	dm.Architecture = fixedContentBuffer[1]
+
+	dm.GlobalMessageNumber = dm.ByteOrder().Uint16(fixedContentBuffer[2:4])
+	if t, ok := GlobalMessageNumber_Types[dm.GlobalMessageNumber]; !ok {
+		dm.GlobalMessageType = GlobalMessageType_Unknown
+	} else {
+		dm.GlobalMessageType = t
+	}
+
+	normalFieldDefinitionsBuffer := make([]byte, int64(dm.NumFields)*3)
+	normalFieldDefinitionsBytesRead, err := readFull(r, normalFieldDefinitionsBuffer)
+	totalBytesRead += normalFieldDefinitionsBytesRead
+	if err != nil {
+		return totalBytesRead, err
+	}
+
+	if err := dm.Fields.Unmarshal(DataRecordFieldType_Normal, normalFieldDefinitionsBuffer); err != nil {
+		return totalBytesRead, err
+	}
+
+	if h.DeveloperData {
+		numDeveloperFieldsBuffer := make([]byte, 1)
+		numDeveloperFieldsBytesRead, err := readFull(r, numDeveloperFieldsBuffer)
+		totalBytesRead += numDeveloperFieldsBytesRead
+		if err != nil {
+			return totalBytesRead, err
+		}
+		numDeveloperFields := numDeveloperFieldsBuffer[0]
+		dm.NumFields += uint8(numDeveloperFields)
+
+		developerFieldDefinitionsBuffer := make([]byte, int64(numDeveloperFields)*3)
+		developerFieldDefinitionsBytesRead, err := readFull(r, developerFieldDefinitionsBuffer)
+		totalBytesRead += developerFieldDefinitionsBytesRead
+		if err != nil {
+			return totalBytesRead, err
+		}
+
+		if err := dm.Fields.Unmarshal(DataRecordFieldType_Developer, developerFieldDefinitionsBuffer); err != nil {
+			return totalBytesRead, err
+		}
+	}
+
+	return totalBytesRead, nil
+}
+
+// ReadAndUnmarshal reads the next data record, decoding it against, and
+// updating, the state of the file it belongs to. ctx is only consulted for
+// cancellation, before anything is read, so a record is never left half read.
+func (dr *DataRecord) ReadAndUnmarshal(ctx context.Context, state *DecoderState, r io.Reader) (int, error) {
+	if dr == nil || state == nil {
+		return 0, ErrorTypeNotDefined
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	var totalBytesRead int
+
+	dr.Header = new(DataRecordHeader)
+
+	// no matter what the message type, the header size is the same
+	buf := make([]byte, 1)
+	n, err := io.ReadFull(r, buf)
+	totalBytesRead += n
+	if err != nil {
+		return totalBytesRead, err
+	}
+
+	if err := dr.Header.Unmarshal(buf); err != nil {
+		return totalBytesRead, err
+	}
+	switch dr.Header.MessageType {
+	case DataRecordMessageType_Definition:
+		dr.DefinitionMessage = new(DefinitionMessage)
+		n, err := dr.DefinitionMessage.ReadAndUnmarshal(ctx, dr.Header, r)
+		totalBytesRead += n
+		if err != nil {
+			return totalBytesRead, err
+		}
+
+		if err := state.definitions.Set(dr.Header.LocalMessageType, dr.DefinitionMessage); err != nil {
+			return totalBytesRead, err
+		}
+	case DataRecordMessageType_Data:
+		def, err := state.definitions.Get(dr.Header.LocalMessageType)
+		if err != nil {
+			return totalBytesRead, err
+		}
+
+		dr.DataMessage = new(DataMessage)
+		n, err := dr.DataMessage.ReadAndUnmarshal(ctx, def, r)
+		totalBytesRead += n
+		if err != nil {
+			return totalBytesRead, err
+		}
+
+		state.timestamps.Resolve(dr.Header, dr.DataMessage)
+		state.accumulators.Accumulate(dr.DataMessage)
+	default:
+		return totalBytesRead, errors.New("unkown message type")
+	}
+
+	return totalBytesRead, nil
+}
+
+func (h *FileHeader) ReadAndUnmarshal(r io.Reader) (int, error) {
+	var totalBytesRead int
+
+	// The first byte holds the size of the header, which tells us how
+	// many more bytes to read.
+	sizeBuffer := make([]byte, 1)
+	n, err := io.ReadFull(r, sizeBuffer)
+	totalBytesRead += n
+	if err != nil {
+		return totalBytesRead, err
+	}
+
+	size := sizeBuffer[0]
+	if size != MinimumHeaderSize && size != MaximumHeaderSize {
+		return totalBytesRead, fmt.Errorf("valid header sizes are %d and %d", MinimumHeaderSize, MaximumHeaderSize)
+	}
+
+	buf := make([]byte, size)
+	buf[0] = size
+	n, err = readFull(r, buf[1:])
+	totalBytesRead += n
+	if err != nil {
+		return totalBytesRead, err
+	}
+
+	return totalBytesRead, h.Unmarshal(buf)
+}
+
+func (f *File) ReadAndUnmarshal(r io.Reader, opts ...DecodeOption) (int, error) {
+	return f.ReadAndUnmarshalContext(context.Background(), r, opts...)
+}
+
+// ReadAndUnmarshalContext is like ReadAndUnmarshal but stops between records
+// once ctx is done, returning ctx.Err() and keeping the records read so far.
+func (f *File) ReadAndUnmarshalContext(ctx context.Context, r io.Reader, opts ...DecodeOption) (int, error) {
+	if f == nil {
+		return 0, ErrorTypeNotDefined
+	}
+
+	if f.Records == nil {
+		f.Records = []DataRecord{}
+	}
+
+	d := NewDecoder(r, opts...)
+	defer func() {
+		f.CRC = d.CRC()
+		f.Warnings = append(f.Warnings, d.Warnings()...)
+	}()
+
+	header, err := d.Header()
+	if err != nil {
+		return d.bytesRead, err
+	}
+	f.Header = header
+
+	for {
+		dr, err := d.NextContext(ctx)
+		if err == io.EOF {
+			return d.bytesRead, nil
+		}
+		if err != nil {
+			return d.bytesRead, err
+		}
+
+		f.Records = append(f.Records, *dr)
+	}
+}
+
+func (c *FileChain) ReadAndUnmarshal(r io.Reader, opts ...DecodeOption) (int, error) {
+	return c.ReadAndUnmarshalContext(context.Background(), r, opts...)
+}
+
+// ReadAndUnmarshalContext is like ReadAndUnmarshal but stops between records
+// once ctx is done, returning ctx.Err() and keeping the files and records
+// read so far.
+func (c *FileChain) ReadAndUnmarshalContext(ctx context.Context, r io.Reader, opts ...DecodeOption) (int, error) {
+	if c == nil {
+		return 0, ErrorTypeNotDefined
+	}
+
+	var totalBytesRead int
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return totalBytesRead, err
+		}
+
+		f := new(File)
+		n, err := f.ReadAndUnmarshalContext(ctx, r, opts...)
+		totalBytesRead += n
+
+		// Running out of bytes exactly at a file boundary ends the chain.
+		if n == 0 && err == io.EOF {
+			return totalBytesRead, nil
+		}
+		if f.Header != nil {
+			*c = append(*c, f)
+		}
+		if err != nil {
+			return totalBytesRead, err
+		}
+	}
+}
+
+func Decode(fileLocation string, opts ...DecodeOption) (*File, error) {
+	rawFile, err := os.Open(fileLocation)
+	if err != nil {
+		return nil, err
+	}
+	defer rawFile.Close()
+
+	return DecodeReader(rawFile, opts...)
+}
+
+// DecodeContext decodes a single FIT file from r. The returned File is never
+// nil and holds whatever was decoded before an error, so callers aborting a
+// decode through ctx still get a partial result alongside ctx.Err().
+func DecodeContext(ctx context.Context, r io.Reader, opts ...DecodeOption) (*File, error) {
+	fitFile := new(File)
+	if _, err := fitFile.ReadAndUnmarshalContext(ctx, r, opts...); err == io.EOF {
+		return fitFile, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return fitFile, err
+	}
+
+	return fitFile, nil
+}
+
+// DecodeChainContext decodes every FIT file stored back to back in r. Like
+// DecodeContext, it returns the files decoded so far alongside any error.
+func DecodeChainContext(ctx context.Context, r io.Reader, opts ...DecodeOption) (FileChain, error) {
+	chain := FileChain{}
+	_, err := chain.ReadAndUnmarshalContext(ctx, r, opts...)
+	return chain, err
+}
+
+// DecodeChain decodes every FIT file stored back to back in fileLocation,
+// which may be gzip compressed.
+func DecodeChain(fileLocation string, opts ...DecodeOption) (FileChain, error) {
+	rawFile, err := os.Open(fileLocation)
+	if err != nil {
+		return nil, err
+	}
+	defer rawFile.Close()
+
+	return DecodeChainReader(rawFile, opts...)
+}
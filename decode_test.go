@@ -0,0 +1,607 @@
+package fit
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestExplodeByte(t *testing.T) {
+	for _, test := range []struct {
+		in  uint8
+		out string
+	}{
+		{0, "00000000"},
+		{255, "11111111"},
+		{1, "00000001"},
+		{254, "11111110"},
+		{32, "00100000"},
+		{132, "10000100"},
+	} {
+		if explodeByte(test.in) != test.out {
+			fmt.Println(explodeByte(test.in))
+			t.Fail()
+		}
+	}
+}
+
+func testFileBytes(records ...[]byte) []byte {
+	return testFileBytesWithHeaderSize(MaximumHeaderSize, records...)
+}
+
+func testFileBytesWithHeaderSize(headerSize uint8, records ...[]byte) []byte {
+	var body []byte
+	for _, record := range records {
+		body = append(body, record...)
+	}
+
+	header := make([]byte, headerSize)
+	header[0] = headerSize
+	header[1] = 0x10
+	binary.LittleEndian.PutUint16(header[2:4], 2130)
+	binary.LittleEndian.PutUint32(header[4:8], uint32(len(body)))
+	copy(header[8:12], ".FIT")
+	if headerSize == MaximumHeaderSize {
+		binary.LittleEndian.PutUint16(header[12:14], ChecksumCRC16(header[:MinimumHeaderSize]))
+	}
+
+	data := append(header, body...)
+	crc := NewCRC16()
+	crc.Write(data)
+	return crc.Sum(data)
+}
+
+func testDefinitionRecord(architecture, localMessageType uint8, globalMessageNumber uint16, fields ...[3]byte) []byte {
+	record := []byte{0x40 | localMessageType, 0, architecture, 0, 0, uint8(len(fields))}
+	(&DefinitionMessage{Architecture: architecture}).ByteOrder().PutUint16(record[3:5], globalMessageNumber)
+	for _, field := range fields {
+		record = append(record, field[:]...)
+	}
+	return record
+}
+
+func testDataRecord(localMessageType uint8, data ...byte) []byte {
+	return append([]byte{localMessageType}, data...)
+}
+
+func testFieldValues(dm *DataMessage) []interface{} {
+	values := []interface{}{}
+	for _, field := range dm.Fields {
+		values = append(values, field.Value)
+	}
+	return values
+}
+
+func TestLocalDefinitions(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureBigEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDefinitionRecord(ArchitectureBigEndian, 1, 0, [3]byte{2, 2, 0x84}),
+		testDataRecord(0, 0x7F),
+		testDataRecord(1, 0x00, 0x01),
+		testDataRecord(0, 0x80),
+		testDefinitionRecord(ArchitectureBigEndian, 0, 21, [3]byte{0, 1, 0x00}, [3]byte{1, 1, 0x00}),
+		testDataRecord(0, 0x01, 0x02),
+		testDataRecord(1, 0x01, 0x00),
+	)
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]interface{}{
+		nil,
+		nil,
+		{uint64(0x7F)},
+		{uint64(0x0001)},
+		{uint64(0x80)},
+		nil,
+		{uint64(0x01), uint64(0x02)},
+		{uint64(0x0100)},
+	}
+	if len(f.Records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(f.Records))
+	}
+	for i, record := range f.Records {
+		if expected[i] == nil {
+			if record.DefinitionMessage == nil {
+				t.Errorf("record %d: expected a definition message", i)
+			}
+			continue
+		}
+		if record.DataMessage == nil {
+			t.Fatalf("record %d: expected a data message", i)
+		}
+		if values := testFieldValues(record.DataMessage); !reflect.DeepEqual(values, expected[i]) {
+			t.Errorf("record %d: expected %v, got %v", i, expected[i], values)
+		}
+	}
+}
+
+func TestLocalDefinitionsUndefined(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureBigEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(2, 0x7F),
+	)
+
+	f := new(File)
+	_, err := f.ReadAndUnmarshal(bytes.NewReader(data))
+	if !errors.Is(err, ErrorLocalMessageTypeNotDefined) {
+		t.Fatalf("expected %v, got %v", ErrorLocalMessageTypeNotDefined, err)
+	}
+}
+
+func TestDataMessageArchitecture(t *testing.T) {
+	for _, test := range []struct {
+		architecture uint8
+		data         []byte
+	}{
+		{
+			architecture: ArchitectureLittleEndian,
+			data:         []byte{0x01, 0x02, 0x01, 0x02, 0x03, 0x04, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		},
+		{
+			architecture: ArchitectureBigEndian,
+			data:         []byte{0x02, 0x01, 0x04, 0x03, 0x02, 0x01, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01},
+		},
+	} {
+		data := testFileBytes(
+			testDefinitionRecord(test.architecture, 0, 20, [3]byte{0, 2, 0x84}, [3]byte{1, 4, 0x86}, [3]byte{2, 8, 0x8F}),
+			testDataRecord(0, test.data...),
+		)
+
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+			t.Fatal(err)
+		}
+		if len(f.Records) != 2 {
+			t.Fatalf("architecture %d: expected 2 records, got %d", test.architecture, len(f.Records))
+		}
+		if f.Records[0].DefinitionMessage.GlobalMessageType != GlobalMessageType_Record {
+			t.Errorf("architecture %d: expected global message type %v, got %v", test.architecture, GlobalMessageType_Record, f.Records[0].DefinitionMessage.GlobalMessageType)
+		}
+
+		// Field 2 is the altitude of a record, whose component expands
+		// into enhanced_altitude after the decoded fields.
+		expected := []interface{}{uint64(0x0201), uint64(0x04030201), uint64(0x0807060504030201), uint64(0x0201)}
+		if values := testFieldValues(f.Records[1].DataMessage); !reflect.DeepEqual(values, expected) {
+			t.Errorf("architecture %d: expected %v, got %v", test.architecture, expected, values)
+		}
+	}
+}
+
+func TestDataMessageProfile(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}, [3]byte{6, 2, 0x84}, [3]byte{2, 2, 0x84}, [3]byte{200, 1, 0x02}),
+		testDataRecord(0, 140, 0x8A, 0x0C, 0x28, 0x0A, 7),
+	)
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	dm := f.Records[1].DataMessage
+
+	for _, test := range []struct {
+		name   string
+		scaled float64
+		units  string
+	}{
+		{"heart_rate", 140, "bpm"},
+		{"speed", 3.21, "m/s"},
+		{"altitude", 20, "m"},
+	} {
+		field, ok := dm.FieldByName(test.name)
+		if !ok {
+			t.Errorf("%s: expected field", test.name)
+			continue
+		}
+		if v, ok := field.Scaled(); !ok || v != test.scaled || field.Units != test.units {
+			t.Errorf("%s: expected %v %s, got %v %s", test.name, test.scaled, test.units, v, field.Units)
+		}
+	}
+
+	out, err := json.Marshal(dm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"global_message_type":"RECORD","fields":{` +
+		`"heart_rate":{"number":3,"base_type":"uint8","value":140,"units":"bpm"},` +
+		`"speed":{"number":6,"base_type":"uint16","value":3.21,"raw_value":3210,"units":"m/s"},` +
+		`"altitude":{"number":2,"base_type":"uint16","value":20,"raw_value":2600,"units":"m"},` +
+		`"unknown_200":{"number":200,"base_type":"uint8","value":7},` +
+		`"enhanced_speed":{"number":73,"base_type":"uint32","value":3.21,"raw_value":3210,"units":"m/s"},` +
+		`"enhanced_altitude":{"number":78,"base_type":"uint32","value":20,"raw_value":2600,"units":"m"}` +
+		`},"developer_fields":[]}`
+	if string(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+}
+
+func TestDataMessageTimestampJSON(t *testing.T) {
+	for _, test := range []struct {
+		timestamp uint32
+		expected  string
+	}{
+		{1000000000, `"timestamp":"2021-09-08T01:46:40Z"`},
+		{DateTimeMin - 1, `"timestamp":268435455`},
+	} {
+		timestamp := test.timestamp
+		out, err := json.Marshal(&DataMessage{GlobalMessageType: GlobalMessageType_Record, Timestamp: &timestamp})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(out), test.expected) {
+			t.Errorf("%d: expected %s in %s", test.timestamp, test.expected, out)
+		}
+	}
+}
+
+func TestFileCRC(t *testing.T) {
+	valid := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x7F),
+	)
+
+	badHeader := append([]byte{}, valid...)
+	badHeader[12] ^= 0xFF
+
+	badFile := append([]byte{}, valid...)
+	badFile[len(badFile)-1] ^= 0xFF
+
+	for _, test := range []struct {
+		name     string
+		data     []byte
+		mode     CRCMode
+		scope    CRCScope
+		warnings int
+	}{
+		{name: "valid", data: valid, mode: CRCModeStrict},
+		{name: "bad header strict", data: badHeader, mode: CRCModeStrict, scope: CRCScopeHeader},
+		{name: "bad header warn", data: badHeader, mode: CRCModeWarn, warnings: 2},
+		{name: "bad file strict", data: badFile, mode: CRCModeStrict, scope: CRCScopeFile},
+		{name: "bad file warn", data: badFile, mode: CRCModeWarn, warnings: 1},
+	} {
+		f := new(File)
+		_, err := f.ReadAndUnmarshal(bytes.NewReader(test.data), WithCRCMode(test.mode))
+
+		var mismatch *ErrCRCMismatch
+		if test.scope != "" {
+			if !errors.As(err, &mismatch) || mismatch.Scope != test.scope {
+				t.Errorf("%s: expected %s crc mismatch, got %v", test.name, test.scope, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if len(f.Warnings) != test.warnings {
+			t.Errorf("%s: expected %d warnings, got %v", test.name, test.warnings, f.Warnings)
+		}
+		if len(f.Records) != 2 {
+			t.Errorf("%s: expected 2 records, got %d", test.name, len(f.Records))
+		}
+	}
+}
+
+func testCompressedTimestampDataRecord(localMessageType, timeOffset uint8, data ...byte) []byte {
+	return append([]byte{0x80 | localMessageType<<5 | timeOffset}, data...)
+}
+
+func TestCompressedTimestamp(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 1, 20, [3]byte{3, 1, 0x02}),
+		testCompressedTimestampDataRecord(1, 4, 0x01),
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{FieldNumberTimestamp, 4, 0x86}, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x3E, 0x00, 0x00, 0x00, 0x01),
+		testCompressedTimestampDataRecord(1, 31, 0x01),
+		testCompressedTimestampDataRecord(1, 2, 0x01),
+		testCompressedTimestampDataRecord(1, 2, 0x01),
+		testDataRecord(0, 0x00, 0x01, 0x00, 0x00, 0x01),
+		testCompressedTimestampDataRecord(1, 1, 0x01),
+	)
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	var timestamps []interface{}
+	for _, record := range f.Records {
+		if record.DataMessage == nil {
+			continue
+		}
+		if record.DataMessage.Timestamp == nil {
+			timestamps = append(timestamps, nil)
+			continue
+		}
+		timestamps = append(timestamps, *record.DataMessage.Timestamp)
+	}
+
+	expected := []interface{}{nil, uint32(62), uint32(63), uint32(66), uint32(66), uint32(256), uint32(257)}
+	if !reflect.DeepEqual(timestamps, expected) {
+		t.Errorf("expected %v, got %v", expected, timestamps)
+	}
+}
+
+func TestDropInvalidFields(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}, [3]byte{4, 1, 0x02}),
+		testDataRecord(0, 0xFF, 0x5A),
+	)
+
+	for _, test := range []struct {
+		opts     []DecodeOption
+		expected []interface{}
+	}{
+		{nil, []interface{}{uint64(0xFF), uint64(0x5A)}},
+		{[]DecodeOption{WithDropInvalidFields()}, []interface{}{uint64(0x5A)}},
+	} {
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(data), test.opts...); err != nil {
+			t.Fatal(err)
+		}
+		if values := testFieldValues(f.Records[1].DataMessage); !reflect.DeepEqual(values, test.expected) {
+			t.Errorf("expected %v, got %v", test.expected, values)
+		}
+	}
+}
+
+func TestFileChain(t *testing.T) {
+	first := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 0, [3]byte{1, 2, 0x84}),
+		testDataRecord(0, 0x01, 0x00),
+	)
+	second := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x7F),
+		testDataRecord(0, 0x80),
+	)
+
+	chain := FileChain{}
+	n, err := chain.ReadAndUnmarshal(bytes.NewReader(append(append([]byte{}, first...), second...)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(first)+len(second) {
+		t.Errorf("expected %d bytes read, got %d", len(first)+len(second), n)
+	}
+	if len(chain) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(chain))
+	}
+	if len(chain[0].Records) != 2 || len(chain[1].Records) != 3 {
+		t.Errorf("expected 2 and 3 records, got %d and %d", len(chain[0].Records), len(chain[1].Records))
+	}
+	if chain[0].CRC != binary.LittleEndian.Uint16(first[len(first)-CRCSize:]) {
+		t.Errorf("expected first file crc 0x%04X, got 0x%04X", binary.LittleEndian.Uint16(first[len(first)-CRCSize:]), chain[0].CRC)
+	}
+	if chain[1].CRC != binary.LittleEndian.Uint16(second[len(second)-CRCSize:]) {
+		t.Errorf("expected second file crc 0x%04X, got 0x%04X", binary.LittleEndian.Uint16(second[len(second)-CRCSize:]), chain[1].CRC)
+	}
+
+	// Definitions do not carry over from one file to the next.
+	orphan := testFileBytes(testDataRecord(0, 0x7F))
+	chain = FileChain{}
+	if _, err := chain.ReadAndUnmarshal(bytes.NewReader(append(append([]byte{}, first...), orphan...))); !errors.Is(err, ErrorLocalMessageTypeNotDefined) {
+		t.Errorf("expected %v, got %v", ErrorLocalMessageTypeNotDefined, err)
+	}
+}
+
+func TestFileHeaderSize(t *testing.T) {
+	records := [][]byte{
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x7F),
+	}
+
+	for _, test := range []struct {
+		size   uint8
+		hasCRC bool
+	}{
+		{MinimumHeaderSize, false},
+		{MaximumHeaderSize, true},
+	} {
+		data := testFileBytesWithHeaderSize(test.size, records...)
+
+		f := new(File)
+		n, err := f.ReadAndUnmarshal(bytes.NewReader(data))
+		if err != nil {
+			t.Errorf("header size %d: unexpected error %v", test.size, err)
+			continue
+		}
+		if n != len(data) {
+			t.Errorf("header size %d: expected %d bytes read, got %d", test.size, len(data), n)
+		}
+		if f.Header.Size != test.size || f.Header.HasCRC != test.hasCRC {
+			t.Errorf("header size %d: expected size %d with crc %t, got size %d with crc %t", test.size, test.size, test.hasCRC, f.Header.Size, f.Header.HasCRC)
+		}
+		if f.Header.DataSize != uint32(len(data))-uint32(test.size)-CRCSize {
+			t.Errorf("header size %d: unexpected data size %d", test.size, f.Header.DataSize)
+		}
+		if len(f.Records) != 2 {
+			t.Errorf("header size %d: expected 2 records, got %d", test.size, len(f.Records))
+		}
+	}
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader([]byte{13, 0x10})); err == nil {
+		t.Error("expected an error for an unsupported header size")
+	}
+}
+
+func TestDecodeHeaderSizeFixtures(t *testing.T) {
+	for _, test := range []struct {
+		file   string
+		hasCRC bool
+	}{
+		{"testdata/header_12.fit", false},
+		{"testdata/header_14.fit", true},
+	} {
+		data, err := ioutil.ReadFile(test.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Read the file directly, as Decode does not report io.EOF.
+		f := new(File)
+		if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+			t.Errorf("%s: unexpected error %v", test.file, err)
+			continue
+		}
+		if f.Header.HasCRC != test.hasCRC {
+			t.Errorf("%s: expected header crc to be %t", test.file, test.hasCRC)
+		}
+		if len(f.Records) != 6 {
+			t.Errorf("%s: expected 6 records, got %d", test.file, len(f.Records))
+			continue
+		}
+		if timestamp := f.Records[5].DataMessage.Timestamp; timestamp == nil || *timestamp != 0x1001 {
+			t.Errorf("%s: expected compressed timestamp 0x1001, got %v", test.file, timestamp)
+		}
+	}
+}
+
+func TestReadAndUnmarshalShortReads(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{FieldNumberTimestamp, 4, 0x86}, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x00, 0x10, 0x00, 0x00, 0x5A),
+		testDefinitionRecord(ArchitectureBigEndian, 1, 20, [3]byte{3, 1, 0x02}, [3]byte{6, 2, 0x84}),
+		testCompressedTimestampDataRecord(1, 1, 0x5B, 0x01, 0x02),
+	)
+
+	expected := new(File)
+	if _, err := expected.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, r := range map[string]io.Reader{
+		"one byte": iotest.OneByteReader(bytes.NewReader(data)),
+		"half":     iotest.HalfReader(bytes.NewReader(data)),
+		"data err": iotest.DataErrReader(bytes.NewReader(data)),
+	} {
+		f := new(File)
+		n, err := f.ReadAndUnmarshal(r)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		if n != len(data) {
+			t.Errorf("%s: expected %d bytes read, got %d", name, len(data), n)
+		}
+		if !reflect.DeepEqual(f, expected) {
+			t.Errorf("%s: decoded file does not match", name)
+		}
+	}
+}
+
+func TestReadAndUnmarshalTruncated(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}, [3]byte{6, 2, 0x84}),
+		testDataRecord(0, 0x5A, 0x01, 0x02),
+	)
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(nil)); err != io.EOF {
+		t.Errorf("empty input: expected %v, got %v", io.EOF, err)
+	}
+
+	for i := 1; i < len(data); i++ {
+		f := new(File)
+		_, err := f.ReadAndUnmarshal(iotest.HalfReader(bytes.NewReader(data[:i])))
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("truncated to %d bytes: expected %v, got %v", i, io.ErrUnexpectedEOF, err)
+		}
+	}
+}
+
+func TestDataRecordReadAndUnmarshalState(t *testing.T) {
+	state := NewDecoderState()
+
+	// A data message without a preceding definition is an error, not a panic.
+	dr := new(DataRecord)
+	if _, err := dr.ReadAndUnmarshal(context.Background(), state, bytes.NewReader(testDataRecord(0, 0x7F))); !errors.Is(err, ErrorLocalMessageTypeNotDefined) {
+		t.Errorf("expected %v, got %v", ErrorLocalMessageTypeNotDefined, err)
+	}
+
+	r := bytes.NewReader(append(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x7F)...,
+	))
+	for i := 0; i < 2; i++ {
+		dr := new(DataRecord)
+		if _, err := dr.ReadAndUnmarshal(context.Background(), state, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := new(DataRecord).ReadAndUnmarshal(ctx, state, bytes.NewReader(testDataRecord(0, 0x7F))); err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+// cancelReader cancels a context once more than limit bytes have been read.
+type cancelReader struct {
+	r      io.Reader
+	limit  int
+	read   int
+	cancel context.CancelFunc
+}
+
+func (r *cancelReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.read += n
+	if r.read > r.limit {
+		r.cancel()
+	}
+	return n, err
+}
+
+func TestDecodeContext(t *testing.T) {
+	definition := testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02})
+	data := testFileBytes(
+		definition,
+		testDataRecord(0, 0x01),
+		testDataRecord(0, 0x02),
+		testDataRecord(0, 0x03),
+	)
+
+	f, err := DecodeContext(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Records) != 4 {
+		t.Errorf("expected 4 records, got %d", len(f.Records))
+	}
+
+	// Cancelling part way through the first data record still finishes it.
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &cancelReader{r: iotest.OneByteReader(bytes.NewReader(data)), limit: MaximumHeaderSize + len(definition), cancel: cancel}
+	f, err = DecodeContext(ctx, r)
+	if err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+	if f == nil || len(f.Records) != 2 {
+		t.Errorf("expected a partial file with 2 records, got %v", f)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), -1)
+	defer cancel()
+	chain, err := DecodeChainContext(ctx, bytes.NewReader(data))
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if len(chain) != 0 {
+		t.Errorf("expected no files, got %d", len(chain))
+	}
+}
@@ -0,0 +1,168 @@
+package fit
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Decoder reads the data records of a FIT file one at a time. Unlike
+// File.ReadAndUnmarshal it only keeps the local definition table in memory,
+// which makes it suitable for very large files.
+//
+// A Decoder never reads past the end of the file it is decoding, so chained
+// files can be decoded by creating a new Decoder over the same reader once
+// Next returns io.EOF.
+type Decoder struct {
+	r       io.Reader
+	tr      io.Reader
+	crc     *CRC16
+	options *decodeOptions
+
+	header    *FileHeader
+	state     *DecoderState
+	remaining int
+	fileCRC   uint16
+	warnings  []error
+	done      bool
+
+	bytesRead int
+}
+
+func NewDecoder(r io.Reader, opts ...DecodeOption) *Decoder {
+	crc := NewCRC16()
+
+	// Everything up to, but not including, the trailing file CRC is
+	// protected by it.
+	return &Decoder{
+		r:       r,
+		tr:      io.TeeReader(r, crc),
+		crc:     crc,
+		options: newDecodeOptions(opts...),
+		state:   NewDecoderState(),
+	}
+}
+
+// Header returns the header of the file, reading it first if Next has not
+// been called yet. It returns io.EOF if the reader holds no more files.
+func (d *Decoder) Header() (*FileHeader, error) {
+	if d.header != nil {
+		return d.header, nil
+	}
+
+	header := new(FileHeader)
+	n, err := header.ReadAndUnmarshal(d.tr)
+	d.bytesRead += n
+	if err := d.checkCRC(err); err != nil {
+		return nil, err
+	}
+
+	d.header = header
+	d.remaining = int(header.DataSize)
+	return d.header, nil
+}
+
+// Next returns the next data record of the file. Once every record has been
+// read, it verifies the file CRC and returns io.EOF.
+func (d *Decoder) Next() (*DataRecord, error) {
+	return d.NextContext(context.Background())
+}
+
+// NextContext is like Next but returns ctx.Err() without reading anything
+// once ctx is done. A record that has started being read is always finished,
+// so decoding can carry on with a later call.
+func (d *Decoder) NextContext(ctx context.Context) (*DataRecord, error) {
+	if d.done {
+		return nil, io.EOF
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := d.Header(); err != nil {
+		return nil, err
+	}
+
+	if d.remaining < 0 {
+		return nil, d.overrun()
+	}
+	if d.remaining == 0 {
+		if err := d.readCRC(); err != nil {
+			return nil, err
+		}
+		d.done = true
+		return nil, io.EOF
+	}
+
+	dr := new(DataRecord)
+	n, err := dr.ReadAndUnmarshal(ctx, d.state, d.tr)
+	d.bytesRead += n
+	d.remaining -= n
+	if err == io.EOF {
+		// The header promised more records.
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	if d.remaining < 0 {
+		// The file CRC would be read from the middle of the record.
+		return nil, d.overrun()
+	}
+
+	if d.options.dropInvalidFields {
+		dr.DataMessage.DropInvalidFields()
+	}
+
+	return dr, nil
+}
+
+// CRC returns the file CRC. It is only meaningful once Next has returned
+// io.EOF.
+func (d *Decoder) CRC() uint16 {
+	return d.fileCRC
+}
+
+// Warnings returns the problems that were tolerated while decoding, such as
+// CRC mismatches when decoding with CRCModeWarn.
+func (d *Decoder) Warnings() []error {
+	return d.warnings
+}
+
+// overrun reports a record that ran past the data size of the header.
+func (d *Decoder) overrun() error {
+	return fmt.Errorf("%w: records run %d bytes past the data size", ErrorMalformedBuffer, -d.remaining)
+}
+
+func (d *Decoder) readCRC() error {
+	buf := make([]byte, CRCSize)
+	n, err := readFull(d.r, buf)
+	d.bytesRead += n
+	if err != nil {
+		return err
+	}
+
+	d.fileCRC = binary.LittleEndian.Uint16(buf)
+	if actual := d.crc.Sum16(); d.fileCRC != actual {
+		err = &ErrCRCMismatch{
+			Scope:    CRCScopeFile,
+			Expected: d.fileCRC,
+			Actual:   actual,
+		}
+	}
+	return d.checkCRC(err)
+}
+
+// checkCRC decides whether err should abort decoding. CRC mismatches are
+// downgraded to warnings when the options allow it.
+func (d *Decoder) checkCRC(err error) error {
+	var mismatch *ErrCRCMismatch
+	if d.options.crcMode == CRCModeWarn && errors.As(err, &mismatch) {
+		d.warnings = append(d.warnings, err)
+		return nil
+	}
+	return err
+}
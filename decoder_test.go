@@ -0,0 +1,146 @@
+package fit
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"testing/iotest"
+)
+
+func TestDecoder(t *testing.T) {
+	first := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x7F),
+		testDataRecord(0, 0x80),
+	)
+	second := testFileBytesWithHeaderSize(MinimumHeaderSize,
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 0, [3]byte{1, 2, 0x84}),
+		testDataRecord(0, 0x01, 0x00),
+	)
+	r := bytes.NewReader(append(append([]byte{}, first...), second...))
+
+	for _, test := range []struct {
+		data    []byte
+		records int
+	}{
+		{first, 3},
+		{second, 2},
+	} {
+		d := NewDecoder(r)
+
+		header, err := d.Header()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header.DataSize != uint32(len(test.data))-uint32(header.Size)-CRCSize {
+			t.Errorf("unexpected data size %d", header.DataSize)
+		}
+
+		records := 0
+		for {
+			record, err := d.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if record.Header == nil {
+				t.Errorf("record %d: expected a header", records)
+			}
+			records++
+		}
+		if records != test.records {
+			t.Errorf("expected %d records, got %d", test.records, records)
+		}
+		if expected := binary.LittleEndian.Uint16(test.data[len(test.data)-CRCSize:]); d.CRC() != expected {
+			t.Errorf("expected crc 0x%04X, got 0x%04X", expected, d.CRC())
+		}
+		if _, err := d.Next(); err != io.EOF {
+			t.Errorf("expected %v after the last record, got %v", io.EOF, err)
+		}
+	}
+
+	if _, err := NewDecoder(r).Next(); err != io.EOF {
+		t.Errorf("expected %v at the end of the chain, got %v", io.EOF, err)
+	}
+}
+
+func TestDecoderNextContext(t *testing.T) {
+	definition := testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02})
+	data := testFileBytes(
+		definition,
+		testDataRecord(0, 0x01),
+		testDataRecord(0, 0x02),
+		testDataRecord(0, 0x03),
+	)
+
+	// Cancel on the header byte of the first data record.
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &cancelReader{r: iotest.OneByteReader(bytes.NewReader(data)), limit: MaximumHeaderSize + len(definition), cancel: cancel}
+	d := NewDecoder(r)
+
+	var values []uint64
+	for {
+		record, err := d.NextContext(ctx)
+		if err == context.Canceled {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record.DataMessage != nil {
+			field, _ := record.DataMessage.Field(3)
+			v, _ := field.Uint64()
+			values = append(values, v)
+		}
+	}
+
+	// The record being read when ctx was cancelled is finished, and a later
+	// call picks up at the next one.
+	for {
+		record, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		field, _ := record.DataMessage.Field(3)
+		v, _ := field.Uint64()
+		values = append(values, v)
+	}
+	if expected := []uint64{1, 2, 3}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+	if expected := binary.LittleEndian.Uint16(data[len(data)-CRCSize:]); d.CRC() != expected {
+		t.Errorf("expected crc 0x%04X, got 0x%04X", expected, d.CRC())
+	}
+}
+
+func TestDecoderRecordOverrunsDataSize(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x01),
+	)
+
+	// The header claims one byte less than the records take up.
+	binary.LittleEndian.PutUint32(data[4:8], binary.LittleEndian.Uint32(data[4:8])-1)
+	binary.LittleEndian.PutUint16(data[12:14], ChecksumCRC16(data[:MinimumHeaderSize]))
+
+	d := NewDecoder(bytes.NewReader(data), WithCRCMode(CRCModeWarn))
+	var err error
+	for err == nil {
+		_, err = d.Next()
+	}
+	if !errors.Is(err, ErrorMalformedBuffer) {
+		t.Errorf("expected %v, got %v", ErrorMalformedBuffer, err)
+	}
+	if _, err := d.Next(); !errors.Is(err, ErrorMalformedBuffer) {
+		t.Errorf("expected %v on the next call, got %v", ErrorMalformedBuffer, err)
+	}
+}
@@ -0,0 +1,68 @@
+// Package fit decodes files in the Flexible and Interoperable Data Transfer
+// (FIT) protocol.
+//
+// Decode and DecodeChain read a file from disk, while DecodeContext,
+// DecodeChainContext and the ReadAndUnmarshal methods on File and FileChain
+// read from any io.Reader. The Context variants stop between records once
+// their context is done:
+//
+//	file, err := fit.Decode("activity.fit")
+//	if err != nil {
+//		return err
+//	}
+//	for _, record := range file.Records {
+//		...
+//	}
+//
+// Decoder reads one data record at a time for files too large to hold in
+// memory.
+//
+// ProfileMessages and ProfileTypes describe the messages, fields and types of
+// the Global FIT Profile. They are generated by internal/cmd/fitgen from the
+// CSV exports of the SDK's Profile.xlsx in the profile directory. Decoded
+// fields the profile describes carry its name, scale, offset and units, and
+// data messages marshal to JSON keyed by field name. A field whose meaning
+// depends on another field of the message, such as the product of a
+// device_info, takes the name, type, scale and units of the subfield its
+// reference field selects, for example garmin_product. Fields with components,
+// such as the compressed_speed_distance of a record, are expanded into the
+// fields their components name, converted to the scale and offset of each
+// destination field. Counters the profile marks as accumulated, such as that
+// distance or the total_cycles of a record, hold running totals across the
+// messages of a file rather than the values that rolled over. Accumulated
+// fields read from a message, such as the cycles of a monitoring message,
+// keep their raw value and carry the running total in Total, which JSON
+// output writes as total.
+//
+// Each profile message also has a generated struct, such as RecordMsg, with
+// typed fields: times for date_time fields, degrees for positions, float64
+// for scaled fields and enum types for enums. NewActivityFile groups the
+// messages of an activity file into these structs.
+//
+// Enum types such as Sport print and marshal to JSON as the profile name of
+// their value, for example cycling. Values the profile does not name print as
+// unknown_ followed by the value and marshal as numbers, so files written by
+// newer profiles still round trip. Decoded enum fields marshal the same way.
+//
+// TimeFromDateTime, TimeFromLocalDateTime and DegreesFromSemicircles convert
+// date_time, local_date_time and position values, and DateTimeFromTime,
+// LocalDateTimeFromTime and SemicirclesFromDegrees convert them back. The
+// generated structs and the JSON output of decoded fields apply them
+// automatically. Times below DateTimeMin are system time, the seconds since
+// the device powered on, and are left as numbers in JSON output.
+//
+// File, FileHeader, DataRecord, DefinitionMessage and DataMessage implement
+// encoding.BinaryMarshaler and io.WriterTo. Encoding a file computes its data
+// size, header CRC and file CRC, and encodes each data message against the
+// definition it was decoded with, so decoding and encoding a file yields the
+// same bytes, including a header CRC of zero and any bytes after the
+// terminator of a string. Fields expanded from components are not encoded.
+//
+// Writer generates a file one message at a time. It allocates local message
+// types itself, writing a definition message only when a message's layout
+// changes and redefining the least recently used local message type once all
+// 16 are in use. Close writes the header and file CRC, patching the header in
+// place when the underlying writer can seek.
+//
+// The command line interface lives in cmd/fit.
+package fit
@@ -0,0 +1,502 @@
+package fit
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	// DefaultProtocolVersion and DefaultProfileVersion are written for
+	// files without a header of their own.
+	DefaultProtocolVersion = 0x10
+	DefaultProfileVersion  = 2130
+
+	// DataTypeFIT is the data type of every FIT file.
+	DataTypeFIT = ".FIT"
+)
+
+// MarshalBinary encodes the file, computing the data size, header CRC and
+// file CRC from the records rather than taking them from f. A file without a
+// header gets a 14 byte header with the default protocol and profile
+// versions.
+func (f *File) MarshalBinary() ([]byte, error) {
+	if f == nil {
+		return nil, ErrorTypeNotDefined
+	}
+
+	var records bytes.Buffer
+	var definitions localDefinitions
+	for i := range f.Records {
+		data, err := f.Records[i].marshal(&definitions)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i, err)
+		}
+		records.Write(data)
+	}
+
+	header := newWriterOptions().header
+	if f.Header != nil {
+		header = *f.Header
+	}
+	header.DataSize = uint32(records.Len())
+
+	data, err := header.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	data = append(data, records.Bytes()...)
+
+	crc := make([]byte, CRCSize)
+	binary.LittleEndian.PutUint16(crc, ChecksumCRC16(data))
+	return append(data, crc...), nil
+}
+
+// WriteTo writes the encoded file to w.
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	return writeBinary(w, f)
+}
+
+// MarshalBinary encodes the header, computing its CRC when HasCRC is set
+// and writing zero, meaning no CRC, otherwise. A header of size zero is
+// written as a 14 byte header with a CRC.
+func (h *FileHeader) MarshalBinary() ([]byte, error) {
+	if h == nil {
+		return nil, ErrorTypeNotDefined
+	}
+
+	size := h.Size
+	if size == 0 {
+		size = MaximumHeaderSize
+	}
+	if size != MinimumHeaderSize && size != MaximumHeaderSize {
+		return nil, fmt.Errorf("valid header sizes are %d and %d", MinimumHeaderSize, MaximumHeaderSize)
+	}
+
+	dataType := h.DataType
+	if dataType == "" {
+		dataType = DataTypeFIT
+	}
+	if len(dataType) != 4 {
+		return nil, fmt.Errorf("data type %q must be 4 bytes", dataType)
+	}
+
+	data := make([]byte, size)
+	data[0] = size
+	data[1] = h.ProtocolVersion
+	binary.LittleEndian.PutUint16(data[2:4], h.ProfileVersion)
+	binary.LittleEndian.PutUint32(data[4:8], h.DataSize)
+	copy(data[8:12], dataType)
+	if size == MaximumHeaderSize && (h.HasCRC || h.Size == 0) {
+		binary.LittleEndian.PutUint16(data[12:14], ChecksumCRC16(data[:MinimumHeaderSize]))
+	}
+	return data, nil
+}
+
+// WriteTo writes the encoded header to w.
+func (h *FileHeader) WriteTo(w io.Writer) (int64, error) {
+	return writeBinary(w, h)
+}
+
+// MarshalBinary encodes the record. Data messages are encoded against the
+// definition they were decoded with, or one derived from their fields.
+func (dr *DataRecord) MarshalBinary() ([]byte, error) {
+	return dr.marshal(nil)
+}
+
+// WriteTo writes the encoded record to w.
+func (dr *DataRecord) WriteTo(w io.Writer) (int64, error) {
+	return writeBinary(w, dr)
+}
+
+// marshal encodes the record. When definitions is not nil, it is updated
+// with definition messages and data messages are encoded against it, as a
+// decoder reading the records back would.
+func (dr *DataRecord) marshal(definitions *localDefinitions) ([]byte, error) {
+	if dr == nil || dr.Header == nil {
+		return nil, ErrorTypeNotDefined
+	}
+
+	header, err := dr.Header.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	switch dr.Header.MessageType {
+	case DataRecordMessageType_Definition:
+		if dr.DefinitionMessage == nil {
+			return nil, ErrorTypeNotDefined
+		}
+		if body, err = dr.DefinitionMessage.marshal(dr.Header.DeveloperData); err != nil {
+			return nil, err
+		}
+		if definitions != nil {
+			if err := definitions.Set(dr.Header.LocalMessageType, dr.DefinitionMessage); err != nil {
+				return nil, err
+			}
+		}
+	case DataRecordMessageType_Data:
+		if dr.DataMessage == nil {
+			return nil, ErrorTypeNotDefined
+		}
+		var def *DefinitionMessage
+		if definitions != nil {
+			def, err = definitions.Get(dr.Header.LocalMessageType)
+		} else {
+			def, err = dr.DataMessage.Definition()
+		}
+		if err != nil {
+			return nil, err
+		}
+		if body, err = dr.DataMessage.marshal(def); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown data record message type %d", dr.Header.MessageType)
+	}
+
+	return append(header, body...), nil
+}
+
+// MarshalBinary encodes the one byte record header.
+func (h *DataRecordHeader) MarshalBinary() ([]byte, error) {
+	if h == nil {
+		return nil, ErrorTypeNotDefined
+	}
+
+	if h.Type == DataRecordHeaderType_CompressedTimestamp {
+		if h.MessageType != DataRecordMessageType_Data {
+			return nil, fmt.Errorf("compressed timestamp headers only precede data messages")
+		}
+		if h.LocalMessageType > 3 {
+			return nil, fmt.Errorf("%w: %d", ErrorLocalMessageTypeOutOfRange, h.LocalMessageType)
+		}
+		return []byte{0x80 | h.LocalMessageType<<5 | h.TimeOffset&compressedTimestampMask}, nil
+	}
+
+	if int(h.LocalMessageType) >= MaxLocalMessageTypes {
+		return nil, fmt.Errorf("%w: %d", ErrorLocalMessageTypeOutOfRange, h.LocalMessageType)
+	}
+	b := h.LocalMessageType
+	if h.MessageType == DataRecordMessageType_Definition {
+		b |= 0x40
+	}
+	if h.DeveloperData {
+		b |= 0x20
+	}
+	return []byte{b}, nil
+}
+
+// MarshalBinary encodes the definition message, without the record header
+// that precedes it. NumFields is computed from Fields.
+func (m *DefinitionMessage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, ErrorTypeNotDefined
+	}
+
+	developerData := false
+	for _, fd := range m.Fields {
+		if fd.Type == DataRecordFieldType_Developer {
+			developerData = true
+		}
+	}
+	return m.marshal(developerData)
+}
+
+// WriteTo writes the encoded definition message to w.
+func (m *DefinitionMessage) WriteTo(w io.Writer) (int64, error) {
+	return writeBinary(w, m)
+}
+
+// marshal encodes the definition message. The count of developer fields is
+// only written when the record header says there is developer data.
+func (m *DefinitionMessage) marshal(developerData bool) ([]byte, error) {
+	if m == nil {
+		return nil, ErrorTypeNotDefined
+	}
+
+	var normal, developer []byte
+	var numNormal, numDeveloper int
+	for _, fd := range m.Fields {
+		if fd.Type == DataRecordFieldType_Developer {
+			developer = append(developer, fd.Number, fd.Size, fd.DeveloperDataIndex)
+			numDeveloper++
+			continue
+		}
+		if fd.BaseType == nil {
+			return nil, fmt.Errorf("field %d: %w", fd.Number, ErrorTypeNotDefined)
+		}
+		normal = append(normal, fd.Number, fd.Size, fd.BaseType.EndianAbility<<7|fd.BaseType.Number)
+		numNormal++
+	}
+	if numNormal > math.MaxUint8 || numDeveloper > math.MaxUint8 {
+		return nil, fmt.Errorf("too many field definitions")
+	}
+	if numDeveloper > 0 && !developerData {
+		return nil, fmt.Errorf("developer field definitions without developer data")
+	}
+
+	number := uint16(m.GlobalMessageType)
+	if m.GlobalMessageType == GlobalMessageType_Unknown {
+		number = m.GlobalMessageNumber
+	}
+
+	data := []byte{0, m.Architecture, 0, 0, uint8(numNormal)}
+	m.ByteOrder().PutUint16(data[2:4], number)
+	data = append(data, normal...)
+	if developerData {
+		data = append(data, uint8(numDeveloper))
+		data = append(data, developer...)
+	}
+	return data, nil
+}
+
+// MarshalBinary encodes the data message, without the record header that
+// precedes it, against the definition returned by Definition.
+func (m *DataMessage) MarshalBinary() ([]byte, error) {
+	def, err := m.Definition()
+	if err != nil {
+		return nil, err
+	}
+	return m.marshal(def)
+}
+
+// WriteTo writes the encoded data message to w.
+func (m *DataMessage) WriteTo(w io.Writer) (int64, error) {
+	return writeBinary(w, m)
+}
+
+// Definition returns the definition the message was decoded with. Messages
+// built by hand get a little endian definition of their fields, in order,
+// sized to hold their values. Fields expanded from components are not part
+// of either.
+func (m *DataMessage) Definition() (*DefinitionMessage, error) {
+	if m == nil {
+		return nil, ErrorTypeNotDefined
+	}
+	if m.definition != nil {
+		return m.definition, nil
+	}
+	if len(m.DeveloperFields) > 0 {
+		return nil, fmt.Errorf("developer fields need a definition: %w", ErrorTypeNotDefined)
+	}
+
+	def := &DefinitionMessage{
+		Architecture:      ArchitectureLittleEndian,
+		GlobalMessageType: m.GlobalMessageType,
+	}
+	if m.GlobalMessageType != GlobalMessageType_Unknown {
+		def.GlobalMessageNumber = uint16(m.GlobalMessageType)
+	}
+
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		if f.Expanded {
+			continue
+		}
+
+		info, ok := BaseTypeNumber_Infos[f.BaseType]
+		if !ok {
+			return nil, fmt.Errorf("field %d: unknown base type number %d", f.Number, f.BaseType)
+		}
+
+		size := info.Size
+		switch v := f.Value.(type) {
+		case string:
+			// Leave room for the null terminator.
+			size = len(v) + 1
+		case []byte:
+			size = len(v)
+		case []uint64:
+			size *= len(v)
+		case []int64:
+			size *= len(v)
+		case []float64:
+			size *= len(v)
+		}
+		if size == 0 || size > math.MaxUint8 {
+			return nil, fmt.Errorf("%w: field %d needs %d bytes", ErrorFieldSizeMismatch, f.Number, size)
+		}
+
+		var endianAbility uint8
+		if info.Size > 1 {
+			endianAbility = 1
+		}
+		def.Fields = append(def.Fields, FieldDefinition{
+			Type:   DataRecordFieldType_Normal,
+			Number: f.Number,
+			Size:   uint8(size),
+			BaseType: &BaseType{
+				Number:        f.BaseType,
+				EndianAbility: endianAbility,
+			},
+		})
+	}
+	if len(def.Fields) > math.MaxUint8 {
+		return nil, fmt.Errorf("too many fields")
+	}
+	def.NumFields = uint8(len(def.Fields))
+	return def, nil
+}
+
+// marshal encodes the message against def. Normal fields missing from the
+// message, such as those dropped by DropInvalidFields, are written as the
+// invalid value of their base type.
+func (m *DataMessage) marshal(def *DefinitionMessage) ([]byte, error) {
+	if m == nil || def == nil {
+		return nil, ErrorTypeNotDefined
+	}
+
+	byteOrder := def.ByteOrder()
+	used := make([]bool, len(m.Fields))
+	developer := 0
+
+	data := make([]byte, 0, def.DataMessageSize())
+	for _, fd := range def.Fields {
+		if fd.Type == DataRecordFieldType_Developer {
+			if developer >= len(m.DeveloperFields) {
+				return nil, fmt.Errorf("developer field %d: %w", fd.Number, ErrorTypeNotDefined)
+			}
+			value := m.DeveloperFields[developer]
+			developer++
+			if len(value) != int(fd.Size) {
+				return nil, fmt.Errorf("%w: developer field %d holds %d bytes, definition has %d", ErrorFieldSizeMismatch, fd.Number, len(value), fd.Size)
+			}
+			data = append(data, value...)
+			continue
+		}
+
+		var field *Field
+		for i := range m.Fields {
+			if !used[i] && !m.Fields[i].Expanded && m.Fields[i].Number == fd.Number {
+				used[i] = true
+				field = &m.Fields[i]
+				break
+			}
+		}
+
+		value, err := field.marshal(fd, byteOrder)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, value...)
+	}
+	return data, nil
+}
+
+// marshal encodes the value of the field as described by fd. A nil field is
+// encoded as the invalid value of the base type.
+func (f *Field) marshal(fd FieldDefinition, byteOrder binary.ByteOrder) ([]byte, error) {
+	if fd.BaseType == nil {
+		return nil, ErrorTypeNotDefined
+	}
+	info, ok := BaseTypeNumber_Infos[fd.BaseType.Number]
+	if !ok {
+		return nil, fmt.Errorf("unknown base type number %d", fd.BaseType.Number)
+	}
+
+	data := make([]byte, fd.Size)
+	mismatch := func(n int) error {
+		return fmt.Errorf("%w: field %d holds %d bytes, definition has %d", ErrorFieldSizeMismatch, fd.Number, n, fd.Size)
+	}
+
+	var value interface{}
+	if f != nil {
+		value = f.Value
+	}
+
+	switch v := value.(type) {
+	case nil:
+		if info.Kind != BaseTypeKind_String {
+			for i := 0; i+info.Size <= len(data); i += info.Size {
+				putUint(data[i:], info.Invalid, info.Size, byteOrder)
+			}
+		}
+		return data, nil
+	case string:
+		// Bytes that followed the terminator of a decoded string are
+		// written back while the string is unchanged. Otherwise unused
+		// bytes are left as null terminators.
+		if f.raw != nil && len(f.raw) == len(data) && bytes.IndexByte(f.raw, 0) == len(v) && string(f.raw[:len(v)]) == v {
+			copy(data, f.raw)
+			return data, nil
+		}
+		if len(v) > len(data) {
+			return nil, mismatch(len(v))
+		}
+		copy(data, v)
+		return data, nil
+	case []byte:
+		if len(v) != len(data) {
+			return nil, mismatch(len(v))
+		}
+		copy(data, v)
+		return data, nil
+	}
+
+	var raw []uint64
+	switch v := value.(type) {
+	case uint64:
+		raw = []uint64{v}
+	case int64:
+		raw = []uint64{uint64(v)}
+	case float64:
+		raw = []uint64{floatBits(v, info.Size)}
+	case []uint64:
+		raw = v
+	case []int64:
+		for _, e := range v {
+			raw = append(raw, uint64(e))
+		}
+	case []float64:
+		for _, e := range v {
+			raw = append(raw, floatBits(e, info.Size))
+		}
+	default:
+		return nil, fmt.Errorf("field %d: cannot encode %T", fd.Number, value)
+	}
+	if info.Kind == BaseTypeKind_String || info.Kind == BaseTypeKind_Byte {
+		return nil, fmt.Errorf("field %d: cannot encode %T as %s", fd.Number, value, info.Name)
+	}
+
+	if len(raw)*info.Size != len(data) {
+		return nil, mismatch(len(raw) * info.Size)
+	}
+	for i, r := range raw {
+		putUint(data[i*info.Size:], r, info.Size, byteOrder)
+	}
+	return data, nil
+}
+
+func floatBits(v float64, size int) uint64 {
+	if size == 4 {
+		return uint64(math.Float32bits(float32(v)))
+	}
+	return math.Float64bits(v)
+}
+
+func putUint(data []byte, v uint64, size int, byteOrder binary.ByteOrder) {
+	switch size {
+	case 1:
+		data[0] = uint8(v)
+	case 2:
+		byteOrder.PutUint16(data, uint16(v))
+	case 4:
+		byteOrder.PutUint32(data, uint32(v))
+	case 8:
+		byteOrder.PutUint64(data, v)
+	}
+}
+
+func writeBinary(w io.Writer, m encoding.BinaryMarshaler) (int64, error) {
+	data, err := m.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(data)
+	return int64(n), err
+}
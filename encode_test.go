@@ -0,0 +1,249 @@
+package fit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"math"
+	"testing"
+)
+
+func testEncodeFileBytes() []byte {
+	// A definition with developer fields, for local message type 2.
+	developerDefinition := []byte{0x40 | 0x20 | 2, 0, ArchitectureLittleEndian, 20, 0, 1, 3, 1, 0x02, 2, 0, 2, 0, 1, 2, 1}
+
+	return testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 0, [3]byte{0, 1, 0x00}, [3]byte{1, 2, 0x84}, [3]byte{4, 4, 0x86}, [3]byte{8, 8, 0x07}),
+		testDataRecord(0, 4, 0x01, 0x00, 0x00, 0x10, 0x00, 0x00, 'a', 'b', 'c', 0, 0, 0, 0, 0),
+		testDefinitionRecord(ArchitectureBigEndian, 1, 20,
+			[3]byte{FieldNumberTimestamp, 4, 0x86},
+			[3]byte{0, 4, 0x85},
+			[3]byte{8, 3, 0x0D},
+			[3]byte{13, 1, 0x01},
+			[3]byte{100, 4, 0x88},
+			[3]byte{101, 6, 0x84},
+			[3]byte{102, 3, 0x84},
+		),
+		testDataRecord(1,
+			0x00, 0x00, 0x10, 0x00,
+			0xE0, 0x00, 0x00, 0x00,
+			0x41, 0x01, 0x64,
+			0xF6,
+			0xFF, 0xFF, 0xFF, 0xFF,
+			0x00, 0x01, 0xFF, 0xFF, 0x00, 0x03,
+			0x01, 0x02, 0x03,
+		),
+		testCompressedTimestampDataRecord(1, 3,
+			0x7F, 0xFF, 0xFF, 0xFF,
+			0x3F, 0x80, 0x00, 0x00,
+			0xFF, 0xFF, 0xFF,
+			0x7F,
+			0x3D, 0xCC, 0xCC, 0xCD,
+			0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+			0xFF, 0xFF, 0xFF,
+		),
+		developerDefinition,
+		testDataRecord(2, 140, 0xDE, 0xAD, 0xBE, 0xEF),
+		testDefinitionRecord(ArchitectureLittleEndian, 3, 0xFF01, [3]byte{0, 2, 0x84}),
+		testDataRecord(3, 0x34, 0x12),
+		testDefinitionRecord(ArchitectureLittleEndian, 4, 55, [3]byte{3, 4, 0x86}),
+		testDataRecord(4, 0xF0, 0xFF, 0xFF, 0xFF),
+		testDataRecord(4, 0x10, 0x00, 0x00, 0x00),
+	)
+}
+
+// testWithoutHeaderCRC replaces the header CRC of a file with zero, as
+// encoders that skip it do, and updates the file CRC to match.
+func testWithoutHeaderCRC(data []byte) []byte {
+	data = append([]byte{}, data[:len(data)-CRCSize]...)
+	data[12], data[13] = 0, 0
+	crc := NewCRC16()
+	crc.Write(data)
+	return crc.Sum(data)
+}
+
+func TestFileMarshalBinaryRoundTrip(t *testing.T) {
+	fixtures := map[string][]byte{
+		"synthetic":         testEncodeFileBytes(),
+		"header 12":         testFileBytesWithHeaderSize(MinimumHeaderSize, testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}), testDataRecord(0, 90)),
+		"no header crc":     testWithoutHeaderCRC(testFileBytes(testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}), testDataRecord(0, 90))),
+		"string after null": testFileBytes(testDefinitionRecord(ArchitectureLittleEndian, 0, 0, [3]byte{8, 6, 0x07}), testDataRecord(0, 'a', 'b', 0, 'c', 0, 0xFF)),
+	}
+	for _, name := range []string{"testdata/header_12.fit", "testdata/header_14.fit"} {
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fixtures[name] = data
+	}
+
+	for name, data := range fixtures {
+		for _, opts := range [][]DecodeOption{nil, {WithDropInvalidFields()}} {
+			f := new(File)
+			if _, err := f.ReadAndUnmarshal(bytes.NewReader(data), opts...); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			out, err := f.MarshalBinary()
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+				continue
+			}
+			if !bytes.Equal(out, data) {
+				t.Errorf("%s: expected\n%x\ngot\n%x", name, data, out)
+			}
+
+			var buf bytes.Buffer
+			n, err := f.WriteTo(&buf)
+			if err != nil || n != int64(len(data)) || !bytes.Equal(buf.Bytes(), data) {
+				t.Errorf("%s: WriteTo wrote %d bytes, %v", name, n, err)
+			}
+		}
+	}
+}
+
+func TestFileMarshalBinaryComputesSizes(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 90),
+	)
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	f.Header.DataSize = 0
+	f.Header.CRC = 0
+	f.CRC = 0
+	f.Records = append(f.Records, DataRecord{
+		Header:      &DataRecordHeader{Type: DataRecordHeaderType_Normal, MessageType: DataRecordMessageType_Data},
+		DataMessage: &DataMessage{Fields: []Field{{Number: 3, BaseType: BaseTypeNumber_Uint8, Value: uint64(91)}}},
+	})
+
+	out, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 90),
+		testDataRecord(0, 91),
+	)
+	if !bytes.Equal(out, expected) {
+		t.Errorf("expected\n%x\ngot\n%x", expected, out)
+	}
+
+	// Files without a header get the default one.
+	f.Header = nil
+	if out, err = f.MarshalBinary(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, expected) {
+		t.Errorf("expected\n%x\ngot\n%x", expected, out)
+	}
+}
+
+func TestFileMarshalBinaryErrors(t *testing.T) {
+	normal := &DataRecordHeader{Type: DataRecordHeaderType_Normal, MessageType: DataRecordMessageType_Data}
+	definition := &DataRecordHeader{Type: DataRecordHeaderType_Normal, MessageType: DataRecordMessageType_Definition}
+	def := &DefinitionMessage{
+		GlobalMessageType: GlobalMessageType_Record,
+		Fields:            FieldDefinitions{{Type: DataRecordFieldType_Normal, Number: 3, Size: 1, BaseType: &BaseType{Number: BaseTypeNumber_Uint8}}},
+	}
+
+	for _, test := range []struct {
+		name    string
+		records []DataRecord
+		err     error
+	}{
+		{
+			name:    "no definition",
+			records: []DataRecord{{Header: normal, DataMessage: &DataMessage{}}},
+			err:     ErrorLocalMessageTypeNotDefined,
+		},
+		{
+			name: "too large",
+			records: []DataRecord{
+				{Header: definition, DefinitionMessage: def},
+				{Header: normal, DataMessage: &DataMessage{Fields: []Field{{Number: 3, BaseType: BaseTypeNumber_Uint16, Value: []uint64{1, 2}}}}},
+			},
+			err: ErrorFieldSizeMismatch,
+		},
+		{
+			name: "local message type",
+			records: []DataRecord{
+				{Header: &DataRecordHeader{Type: DataRecordHeaderType_CompressedTimestamp, MessageType: DataRecordMessageType_Data, LocalMessageType: 4}, DataMessage: &DataMessage{}},
+			},
+			err: ErrorLocalMessageTypeOutOfRange,
+		},
+	} {
+		f := &File{Records: test.records}
+		if _, err := f.MarshalBinary(); !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+		}
+	}
+}
+
+func TestDataMessageMarshalBinary(t *testing.T) {
+	m := &DataMessage{
+		GlobalMessageType: GlobalMessageType_Record,
+		Fields: []Field{
+			{Number: FieldNumberTimestamp, BaseType: BaseTypeNumber_Uint32, Value: uint64(0x1000)},
+			{Number: 0, BaseType: BaseTypeNumber_Sint32, Value: int64(-2)},
+			{Number: 2, BaseType: BaseTypeNumber_Float32, Value: math.NaN()},
+			{Number: 3, BaseType: BaseTypeNumber_String, Value: "ab"},
+			{Number: 4, BaseType: BaseTypeNumber_Uint16, Value: []uint64{1, 2}},
+			{Number: 73, BaseType: BaseTypeNumber_Uint32, Value: uint64(1), Expanded: true},
+		},
+	}
+
+	def, err := m.Definition()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.NumFields != 5 || def.Fields[3].Size != 3 || def.Fields[4].Size != 4 || def.Fields[4].BaseType.EndianAbility != 1 {
+		t.Errorf("unexpected definition %+v", def)
+	}
+
+	out, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nan := math.Float32bits(float32(math.NaN()))
+	expected := []byte{
+		0x00, 0x10, 0x00, 0x00,
+		0xFE, 0xFF, 0xFF, 0xFF,
+		uint8(nan), uint8(nan >> 8), uint8(nan >> 16), uint8(nan >> 24),
+		'a', 'b', 0,
+		0x01, 0x00, 0x02, 0x00,
+	}
+	if !bytes.Equal(out, expected) {
+		t.Errorf("expected %x, got %x", expected, out)
+	}
+
+	_, err = (&DataMessage{DeveloperFields: [][]byte{{1}}}).Definition()
+	if !errors.Is(err, ErrorTypeNotDefined) {
+		t.Errorf("expected %v, got %v", ErrorTypeNotDefined, err)
+	}
+}
+
+func TestDefinitionMessageMarshalBinary(t *testing.T) {
+	data := []byte{0, ArchitectureBigEndian, 0xFF, 0x01, 1, 0, 2, 0x84, 1, 2, 4, 0}
+
+	def := new(DefinitionMessage)
+	if _, err := def.ReadAndUnmarshal(context.Background(), &DataRecordHeader{DeveloperData: true}, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if def.GlobalMessageType != GlobalMessageType_Unknown || def.GlobalMessageNumber != 0xFF01 {
+		t.Errorf("expected unknown global message number 0xFF01, got %v %#x", def.GlobalMessageType, def.GlobalMessageNumber)
+	}
+
+	out, err := def.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Errorf("expected %x, got %x", data, out)
+	}
+}
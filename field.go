@@ -0,0 +1,464 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// BaseTypeKind describes how the bytes of a base type are interpreted.
+type BaseTypeKind int
+
+const (
+	BaseTypeKind_Unsigned BaseTypeKind = iota
+	BaseTypeKind_Signed
+	BaseTypeKind_Float
+	BaseTypeKind_String
+	BaseTypeKind_Byte
+)
+
+// BaseTypeInfo describes a single element of a base type.
+type BaseTypeInfo struct {
+	Name string
+	Size int
+	Kind BaseTypeKind
+	// Invalid is the raw bit pattern an encoder writes when it has no
+	// value for a field.
+	Invalid uint64
+}
+
+var BaseTypeNumber_Infos = map[uint8]BaseTypeInfo{
+	BaseTypeNumber_Enum:    {"enum", 1, BaseTypeKind_Unsigned, 0xFF},
+	BaseTypeNumber_Sint8:   {"sint8", 1, BaseTypeKind_Signed, 0x7F},
+	BaseTypeNumber_Uint8:   {"uint8", 1, BaseTypeKind_Unsigned, 0xFF},
+	BaseTypeNumber_Sint16:  {"sint16", 2, BaseTypeKind_Signed, 0x7FFF},
+	BaseTypeNumber_Uint16:  {"uint16", 2, BaseTypeKind_Unsigned, 0xFFFF},
+	BaseTypeNumber_Sint32:  {"sint32", 4, BaseTypeKind_Signed, 0x7FFFFFFF},
+	BaseTypeNumber_Uint32:  {"uint32", 4, BaseTypeKind_Unsigned, 0xFFFFFFFF},
+	BaseTypeNumber_String:  {"string", 1, BaseTypeKind_String, 0x00},
+	BaseTypeNumber_Float32: {"float32", 4, BaseTypeKind_Float, 0xFFFFFFFF},
+	BaseTypeNumber_Float64: {"float64", 8, BaseTypeKind_Float, 0xFFFFFFFFFFFFFFFF},
+	BaseTypeNumber_Uint8z:  {"uint8z", 1, BaseTypeKind_Unsigned, 0x00},
+	BaseTypeNumber_Uint16z: {"uint16z", 2, BaseTypeKind_Unsigned, 0x0000},
+	BaseTypeNumber_Uint32z: {"uint32z", 4, BaseTypeKind_Unsigned, 0x00000000},
+	BaseTypeNumber_Byte:    {"byte", 1, BaseTypeKind_Byte, 0xFF},
+	BaseTypeNumber_Sint64:  {"sint64", 8, BaseTypeKind_Signed, 0x7FFFFFFFFFFFFFFF},
+	BaseTypeNumber_Uint64:  {"uint64", 8, BaseTypeKind_Unsigned, 0xFFFFFFFFFFFFFFFF},
+	BaseTypeNumber_Uint64z: {"uint64z", 8, BaseTypeKind_Unsigned, 0x0000000000000000},
+}
+
+// Field is a single decoded field of a data message.
+//
+// The type of Value is driven by the base type of the field:
+//
+//	enum, uint*, uint*z   uint64 or []uint64
+//	sint*                 int64 or []int64
+//	float32, float64      float64 or []float64
+//	string                string
+//	byte                  []byte
+//
+// A slice is used when the field size is a multiple of its base type size
+// greater than one. Fields whose size does not line up with their base type
+// are kept as a []byte.
+//
+// Invalid reports whether the encoder wrote the invalid sentinel of the base
+// type, or for arrays, whether every element is the sentinel. Invalid fields
+// marshal to a JSON null and their accessors report no value.
+//
+// Name, Type, Scale, Offset and Units come from the Global FIT Profile and
+// are left empty for fields it does not describe. When a subfield of the
+// field applies, they describe the subfield instead. Value always holds the
+// raw value; Scaled and ScaledValue apply the scale and offset.
+//
+// Expanded reports whether the field was expanded from a component of
+// another field rather than read from the message.
+//
+// Accumulated reports whether the profile marks the field as a counter that
+// rolls over, in which case Total holds the raw running total across the
+// messages of the file.
+type Field struct {
+	Number   uint8       `json:"number"`
+	BaseType uint8       `json:"base_type"`
+	Value    interface{} `json:"value"`
+	Invalid  bool        `json:"-"`
+
+	Name   string  `json:"-"`
+	Type   string  `json:"-"`
+	Scale  float64 `json:"-"`
+	Offset float64 `json:"-"`
+	Units  string  `json:"units,omitempty"`
+
+	Expanded bool `json:"-"`
+
+	Accumulated bool   `json:"-"`
+	Total       uint64 `json:"-"`
+
+	// raw holds the bytes of a string field whose terminator is followed
+	// by more than padding, so that encoding it again writes the same bytes.
+	raw []byte
+}
+
+// Key returns the profile name of the field, or unknown_ followed by the
+// field number for fields the profile does not describe.
+func (f *Field) Key() string {
+	if f.Name != "" {
+		return f.Name
+	}
+	return fmt.Sprintf("unknown_%d", f.Number)
+}
+
+func (f *Field) applyProfile(pf *ProfileField) {
+	f.Name = pf.Name
+	f.Type = pf.Type
+	f.Scale = pf.Scale
+	f.Offset = pf.Offset
+	f.Units = pf.Units
+}
+
+func (f *Field) applySubfield(sf *ProfileSubfield) {
+	f.Name = sf.Name
+	f.Type = sf.Type
+	f.Scale = sf.Scale
+	f.Offset = sf.Offset
+	f.Units = sf.Units
+}
+
+// Uint64 returns the value of a scalar unsigned field.
+func (f *Field) Uint64() (uint64, bool) {
+	if f == nil || f.Invalid {
+		return 0, false
+	}
+	v, ok := f.Value.(uint64)
+	return v, ok
+}
+
+// Int64 returns the value of a scalar signed field.
+func (f *Field) Int64() (int64, bool) {
+	if f == nil || f.Invalid {
+		return 0, false
+	}
+	v, ok := f.Value.(int64)
+	return v, ok
+}
+
+// Float64 returns the value of any scalar numeric field as a float64.
+func (f *Field) Float64() (float64, bool) {
+	if f == nil || f.Invalid {
+		return 0, false
+	}
+	switch v := f.Value.(type) {
+	case uint64:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// Scaled returns the value of a scalar numeric field with the profile's
+// scale and offset applied.
+func (f *Field) Scaled() (float64, bool) {
+	v, ok := f.Float64()
+	if !ok {
+		return 0, false
+	}
+	return f.scale(v), true
+}
+
+// ScaledValue returns Value with the profile's scale and offset applied, as
+// a float64 or []float64. Values of fields that are not scaled, such as
+// strings, enums and counters, are returned unchanged.
+func (f *Field) ScaledValue() interface{} {
+	if !f.isScaled() {
+		return f.Value
+	}
+
+	switch v := f.Value.(type) {
+	case uint64:
+		return f.scale(float64(v))
+	case int64:
+		return f.scale(float64(v))
+	case float64:
+		return f.scale(v)
+	case []uint64:
+		values := make([]float64, len(v))
+		for i := range v {
+			values[i] = f.scale(float64(v[i]))
+		}
+		return values
+	case []int64:
+		values := make([]float64, len(v))
+		for i := range v {
+			values[i] = f.scale(float64(v[i]))
+		}
+		return values
+	case []float64:
+		values := make([]float64, len(v))
+		for i := range v {
+			values[i] = f.scale(v[i])
+		}
+		return values
+	}
+	return f.Value
+}
+
+func (f *Field) isScaled() bool {
+	return (f.Scale != 0 && f.Scale != 1) || f.Offset != 0
+}
+
+func (f *Field) scale(v float64) float64 {
+	if f.Scale != 0 {
+		v /= f.Scale
+	}
+	return v - f.Offset
+}
+
+// MarshalJSON writes the scaled value of the field, or for enum, time and
+// position fields the profile name, time or degrees of the value. The raw
+// value is written alongside it for fields the profile scales, offsets or
+// converts this way, and the running total, scaled like the value, for
+// accumulated fields.
+func (f Field) MarshalJSON() ([]byte, error) {
+	info := BaseTypeNumber_Infos[f.BaseType]
+
+	value := json.RawMessage("null")
+	var rawValue json.RawMessage
+	units := f.Units
+	if !f.Invalid {
+		var err error
+		if f.isScaled() {
+			if value, err = marshalNumbers(f.ScaledValue(), false); err != nil {
+				return nil, err
+			}
+			if rawValue, err = f.marshalValue(); err != nil {
+				return nil, err
+			}
+		} else if converted, convertedUnits, ok := f.convertedValue(); ok {
+			if value, err = json.Marshal(converted); err != nil {
+				return nil, err
+			}
+			if rawValue, err = f.marshalValue(); err != nil {
+				return nil, err
+			}
+			units = convertedUnits
+		} else if value, err = f.marshalValue(); err != nil {
+			return nil, err
+		}
+	}
+
+	var total json.RawMessage
+	if f.Accumulated && !f.Invalid {
+		var v interface{} = f.Total
+		if f.isScaled() {
+			v = f.scale(float64(f.Total))
+		}
+		var err error
+		if total, err = marshalNumbers(v, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(struct {
+		Number   uint8           `json:"number"`
+		BaseType string          `json:"base_type"`
+		Value    json.RawMessage `json:"value"`
+		RawValue json.RawMessage `json:"raw_value,omitempty"`
+		Total    json.RawMessage `json:"total,omitempty"`
+		Units    string          `json:"units,omitempty"`
+	}{
+		Number:   f.Number,
+		BaseType: info.Name,
+		Value:    value,
+		RawValue: rawValue,
+		Total:    total,
+		Units:    units,
+	})
+}
+
+// convertedValue returns the value JSON output uses in place of the raw
+// value of the field, along with its units: the profile name of enums, the
+// time of date_time and local_date_time fields holding absolute times, and
+// degrees for positions.
+func (f *Field) convertedValue() (interface{}, string, bool) {
+	switch v := f.Value.(type) {
+	case uint64:
+		switch {
+		case f.Type == typeNameDateTime && !IsSystemTime(uint32(v)):
+			return TimeFromDateTime(uint32(v)).Format(time.RFC3339), "", true
+		case f.Type == typeNameLocalDateTime && !IsSystemTime(uint32(v)):
+			return TimeFromLocalDateTime(uint32(v), time.UTC).Format(localDateTimeLayout), "", true
+		}
+		if name, ok := f.enumName(); ok {
+			return name, f.Units, true
+		}
+	case int64:
+		if f.Units == unitsSemicircles {
+			return DegreesFromSemicircles(int32(v)), unitsDegrees, true
+		}
+	}
+	return nil, "", false
+}
+
+// enumName returns the profile name of the value of an enum field.
+func (f *Field) enumName() (string, bool) {
+	t, ok := ProfileTypes[f.Type]
+	if !ok || !t.Enum {
+		return "", false
+	}
+	v, ok := f.Value.(uint64)
+	if !ok {
+		return "", false
+	}
+	name, ok := t.Values[v]
+	return name, ok
+}
+
+func (f *Field) marshalValue() (json.RawMessage, error) {
+	// Format float32 values with 32 bit precision so 0.1 does not
+	// become 0.10000000149011612.
+	return marshalNumbers(f.Value, f.BaseType == BaseTypeNumber_Float32)
+}
+
+// marshalNumbers marshals v, writing NaN and infinite floats, which JSON
+// cannot represent, as null. Floats are formatted with 32 bit precision when
+// single is set.
+func marshalNumbers(v interface{}, single bool) ([]byte, error) {
+	switch v := v.(type) {
+	case float64:
+		return marshalFloat(v, single), nil
+	case []float64:
+		buf := []byte{'['}
+		for i, f := range v {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = append(buf, marshalFloat(f, single)...)
+		}
+		return append(buf, ']'), nil
+	}
+	return json.Marshal(v)
+}
+
+func marshalFloat(v float64, single bool) []byte {
+	switch {
+	case math.IsInf(v, 0) || math.IsNaN(v):
+		return []byte("null")
+	case single:
+		return strconv.AppendFloat(nil, v, 'g', -1, 32)
+	}
+	data, _ := json.Marshal(v)
+	return data
+}
+
+func (f *Field) Unmarshal(def FieldDefinition, byteOrder binary.ByteOrder, data []byte) error {
+	if f == nil || def.BaseType == nil || len(data) != int(def.Size) {
+		return ErrorMalformedBuffer
+	}
+
+	f.Number = def.Number
+	f.BaseType = def.BaseType.Number
+
+	info, ok := BaseTypeNumber_Infos[def.BaseType.Number]
+	if !ok {
+		return fmt.Errorf("unknown base type number %d", def.BaseType.Number)
+	}
+
+	switch {
+	case info.Kind == BaseTypeKind_String:
+		// Strings are null terminated, but a string that fills the whole
+		// field may omit the terminator.
+		f.raw = nil
+		if i := bytes.IndexByte(data, 0); i >= 0 {
+			if bytes.Count(data[i:], []byte{0}) != len(data)-i {
+				f.raw = append([]byte{}, data...)
+			}
+			data = data[:i]
+		}
+		f.Value = string(data)
+		f.Invalid = len(data) == 0
+		return nil
+	case info.Kind == BaseTypeKind_Byte, len(data)%info.Size != 0:
+		f.Value = append([]byte{}, data...)
+		f.Invalid = info.Kind == BaseTypeKind_Byte && bytes.Count(data, []byte{0xFF}) == len(data)
+		return nil
+	}
+
+	n := len(data) / info.Size
+	raw := make([]uint64, n)
+	for i := range raw {
+		element := data[i*info.Size : (i+1)*info.Size]
+		switch info.Size {
+		case 1:
+			raw[i] = uint64(element[0])
+		case 2:
+			raw[i] = uint64(byteOrder.Uint16(element))
+		case 4:
+			raw[i] = uint64(byteOrder.Uint32(element))
+		case 8:
+			raw[i] = byteOrder.Uint64(element)
+		}
+	}
+
+	f.Invalid = true
+	for i := range raw {
+		if raw[i] != info.Invalid {
+			f.Invalid = false
+			break
+		}
+	}
+
+	switch info.Kind {
+	case BaseTypeKind_Signed:
+		values := make([]int64, n)
+		for i := range raw {
+			values[i] = signExtend(raw[i], info.Size)
+		}
+		f.Value = scalarOrSlice(values, n)
+	case BaseTypeKind_Float:
+		values := make([]float64, n)
+		for i := range raw {
+			if info.Size == 4 {
+				values[i] = float64(math.Float32frombits(uint32(raw[i])))
+			} else {
+				values[i] = math.Float64frombits(raw[i])
+			}
+		}
+		f.Value = scalarOrSlice(values, n)
+	default:
+		f.Value = scalarOrSlice(raw, n)
+	}
+	return nil
+}
+
+func signExtend(raw uint64, size int) int64 {
+	switch size {
+	case 1:
+		return int64(int8(raw))
+	case 2:
+		return int64(int16(raw))
+	case 4:
+		return int64(int32(raw))
+	}
+	return int64(raw)
+}
+
+func scalarOrSlice(values interface{}, n int) interface{} {
+	if n != 1 {
+		return values
+	}
+	switch v := values.(type) {
+	case []uint64:
+		return v[0]
+	case []int64:
+		return v[0]
+	case []float64:
+		return v[0]
+	}
+	return values
+}
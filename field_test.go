@@ -0,0 +1,167 @@
+package fit
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestFieldUnmarshal(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		baseType uint8
+		data     []byte
+		out      interface{}
+	}{
+		{"uint8", BaseTypeNumber_Uint8, []byte{0x7F}, uint64(0x7F)},
+		{"uint8 array", BaseTypeNumber_Uint8, []byte{0x01, 0x02, 0x03}, []uint64{1, 2, 3}},
+		{"uint16 array", BaseTypeNumber_Uint16, []byte{0x01, 0x00, 0x02, 0x00}, []uint64{1, 2}},
+		{"uint32", BaseTypeNumber_Uint32, []byte{0x01, 0x02, 0x03, 0x04}, uint64(0x04030201)},
+		{"misaligned uint16", BaseTypeNumber_Uint16, []byte{0x01, 0x02, 0x03}, []byte{0x01, 0x02, 0x03}},
+		{"string", BaseTypeNumber_String, []byte("Forerunner\x00\x00\x00"), "Forerunner"},
+		{"unterminated string", BaseTypeNumber_String, []byte("Edge"), "Edge"},
+		{"utf-8 string", BaseTypeNumber_String, []byte("Zürich\x00"), "Zürich"},
+		{"empty string", BaseTypeNumber_String, []byte{0x00, 0x00}, ""},
+		{"sint8", BaseTypeNumber_Sint8, []byte{0xF4}, int64(-12)},
+		{"sint16 array", BaseTypeNumber_Sint16, []byte{0xFF, 0xFF, 0x02, 0x00}, []int64{-1, 2}},
+		{"sint32", BaseTypeNumber_Sint32, []byte{0x00, 0x00, 0x00, 0x80}, int64(math.MinInt32)},
+		{"sint64", BaseTypeNumber_Sint64, []byte{0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, int64(-2)},
+		{"float32", BaseTypeNumber_Float32, []byte{0x00, 0x00, 0x60, 0x40}, 3.5},
+		{"float64 array", BaseTypeNumber_Float64, []byte{0, 0, 0, 0, 0, 0, 0x0C, 0x40, 0, 0, 0, 0, 0, 0, 0xF0, 0xBF}, []float64{3.5, -1}},
+		{"enum", BaseTypeNumber_Enum, []byte{0x05}, uint64(5)},
+		{"uint16z", BaseTypeNumber_Uint16z, []byte{0x00, 0x01}, uint64(0x0100)},
+		{"byte", BaseTypeNumber_Byte, []byte{0x01}, []byte{0x01}},
+		{"byte array", BaseTypeNumber_Byte, []byte{0x01, 0x00, 0xFF}, []byte{0x01, 0x00, 0xFF}},
+	} {
+		def := FieldDefinition{
+			Number:   1,
+			Size:     uint8(len(test.data)),
+			BaseType: &BaseType{Number: test.baseType},
+		}
+
+		var field Field
+		if err := field.Unmarshal(def, binary.LittleEndian, test.data); err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(field.Value, test.out) {
+			t.Errorf("%s: expected %#v, got %#v", test.name, test.out, field.Value)
+		}
+	}
+}
+
+func TestFieldMarshalJSON(t *testing.T) {
+	for _, test := range []struct {
+		field Field
+		out   string
+	}{
+		{Field{Number: 1, BaseType: BaseTypeNumber_Sint8, Value: int64(-12)}, `{"number":1,"base_type":"sint8","value":-12}`},
+		{Field{Number: 2, BaseType: BaseTypeNumber_Float32, Value: 3.5}, `{"number":2,"base_type":"float32","value":3.5}`},
+		{Field{Number: 3, BaseType: BaseTypeNumber_Float32, Value: float64(float32(0.1))}, `{"number":3,"base_type":"float32","value":0.1}`},
+		{Field{Number: 4, BaseType: BaseTypeNumber_Float32, Value: []float64{float64(float32(0.1)), -2}}, `{"number":4,"base_type":"float32","value":[0.1,-2]}`},
+		{Field{Number: 2, BaseType: BaseTypeNumber_Float32, Value: float64(math.Float32frombits(0x7FC00000))}, `{"number":2,"base_type":"float32","value":null}`},
+		{Field{Number: 2, BaseType: BaseTypeNumber_Float64, Value: math.Inf(1)}, `{"number":2,"base_type":"float64","value":null}`},
+		{Field{Number: 4, BaseType: BaseTypeNumber_Float32, Value: []float64{math.Inf(-1), -2}}, `{"number":4,"base_type":"float32","value":[null,-2]}`},
+		{Field{Number: 6, BaseType: BaseTypeNumber_Float32, Value: math.NaN(), Scale: 1000}, `{"number":6,"base_type":"float32","value":null,"raw_value":null}`},
+		{Field{Number: 5, BaseType: BaseTypeNumber_String, Value: "Edge"}, `{"number":5,"base_type":"string","value":"Edge"}`},
+		{Field{Number: 3, BaseType: BaseTypeNumber_Uint8, Value: uint64(140), Name: "heart_rate", Scale: 1, Units: "bpm"}, `{"number":3,"base_type":"uint8","value":140,"units":"bpm"}`},
+		{Field{Number: 6, BaseType: BaseTypeNumber_Uint16, Value: uint64(3210), Name: "speed", Scale: 1000, Units: "m/s"}, `{"number":6,"base_type":"uint16","value":3.21,"raw_value":3210,"units":"m/s"}`},
+		{Field{Number: 6, BaseType: BaseTypeNumber_Uint16, Value: uint64(0xFFFF), Invalid: true, Name: "speed", Scale: 1000, Units: "m/s"}, `{"number":6,"base_type":"uint16","value":null,"units":"m/s"}`},
+		{Field{Number: 5, BaseType: BaseTypeNumber_Enum, Value: uint64(2), Name: "sport", Type: "sport", Scale: 1}, `{"number":5,"base_type":"enum","value":"cycling","raw_value":2}`},
+		{Field{Number: 5, BaseType: BaseTypeNumber_Enum, Value: uint64(200), Name: "sport", Type: "sport", Scale: 1}, `{"number":5,"base_type":"enum","value":200}`},
+		{Field{Number: 2, BaseType: BaseTypeNumber_Uint16, Value: uint64(2), Name: "product", Type: "uint16", Scale: 1}, `{"number":2,"base_type":"uint16","value":2}`},
+		{Field{Number: 253, BaseType: BaseTypeNumber_Uint32, Value: uint64(1000000000), Name: "timestamp", Type: "date_time", Scale: 1, Units: "s"}, `{"number":253,"base_type":"uint32","value":"2021-09-08T01:46:40Z","raw_value":1000000000}`},
+		{Field{Number: 253, BaseType: BaseTypeNumber_Uint32, Value: uint64(0x1000), Name: "timestamp", Type: "date_time", Scale: 1, Units: "s"}, `{"number":253,"base_type":"uint32","value":4096,"units":"s"}`},
+		{Field{Number: 5, BaseType: BaseTypeNumber_Uint32, Value: uint64(1000000000), Name: "local_timestamp", Type: "local_date_time", Scale: 1}, `{"number":5,"base_type":"uint32","value":"2021-09-08T01:46:40","raw_value":1000000000}`},
+		{Field{Number: 0, BaseType: BaseTypeNumber_Sint32, Value: int64(1 << 30), Name: "position_lat", Type: "sint32", Scale: 1, Units: "semicircles"}, `{"number":0,"base_type":"sint32","value":90,"raw_value":1073741824,"units":"degrees"}`},
+	} {
+		out, err := json.Marshal(test.field)
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", test.field, err)
+			continue
+		}
+		if string(out) != test.out {
+			t.Errorf("expected %s, got %s", test.out, out)
+		}
+	}
+}
+
+func TestFieldScaledValue(t *testing.T) {
+	for _, test := range []struct {
+		field Field
+		out   interface{}
+	}{
+		{Field{Value: uint64(3210), Scale: 1000}, 3.21},
+		{Field{Value: uint64(2600), Scale: 5, Offset: 500}, 20.0},
+		{Field{Value: int64(-250), Scale: 100}, -2.5},
+		{Field{Value: []uint64{16, 32}, Scale: 16}, []float64{1, 2}},
+		{Field{Value: uint64(140), Scale: 1}, uint64(140)},
+		{Field{Value: uint64(140)}, uint64(140)},
+		{Field{Value: "Edge", Scale: 1}, "Edge"},
+	} {
+		if out := test.field.ScaledValue(); !reflect.DeepEqual(out, test.out) {
+			t.Errorf("%v: expected %#v, got %#v", test.field.Value, test.out, out)
+		}
+	}
+
+	field := Field{Value: uint64(3210), Scale: 1000}
+	if v, ok := field.Scaled(); !ok || v != 3.21 {
+		t.Errorf("expected 3.21, got %v", v)
+	}
+	field.Invalid = true
+	if _, ok := field.Scaled(); ok {
+		t.Error("expected no value for an invalid field")
+	}
+}
+
+func TestFieldInvalid(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		baseType uint8
+		data     []byte
+		invalid  bool
+	}{
+		{"uint8", BaseTypeNumber_Uint8, []byte{0xFF}, true},
+		{"uint8 valid", BaseTypeNumber_Uint8, []byte{0xFE}, false},
+		{"enum", BaseTypeNumber_Enum, []byte{0xFF}, true},
+		{"sint8", BaseTypeNumber_Sint8, []byte{0x7F}, true},
+		{"sint8 valid", BaseTypeNumber_Sint8, []byte{0xFF}, false},
+		{"sint32", BaseTypeNumber_Sint32, []byte{0xFF, 0xFF, 0xFF, 0x7F}, true},
+		{"uint16z", BaseTypeNumber_Uint16z, []byte{0x00, 0x00}, true},
+		{"uint16z valid", BaseTypeNumber_Uint16z, []byte{0xFF, 0xFF}, false},
+		{"float32", BaseTypeNumber_Float32, []byte{0xFF, 0xFF, 0xFF, 0xFF}, true},
+		{"string", BaseTypeNumber_String, []byte{0x00, 0x00}, true},
+		{"byte array", BaseTypeNumber_Byte, []byte{0xFF, 0xFF}, true},
+		{"byte array partially valid", BaseTypeNumber_Byte, []byte{0xFF, 0x00}, false},
+		{"uint8 array", BaseTypeNumber_Uint8, []byte{0xFF, 0xFF, 0xFF}, true},
+		{"uint8 array partially valid", BaseTypeNumber_Uint8, []byte{0xFF, 0x01, 0xFF}, false},
+	} {
+		def := FieldDefinition{
+			Number:   1,
+			Size:     uint8(len(test.data)),
+			BaseType: &BaseType{Number: test.baseType},
+		}
+
+		var field Field
+		if err := field.Unmarshal(def, binary.LittleEndian, test.data); err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if field.Invalid != test.invalid {
+			t.Errorf("%s: expected invalid to be %t", test.name, test.invalid)
+		}
+		if _, ok := field.Float64(); test.invalid && ok {
+			t.Errorf("%s: expected no value for an invalid field", test.name)
+		}
+	}
+
+	out, err := json.Marshal(Field{Number: 3, BaseType: BaseTypeNumber_Uint8, Value: uint64(0xFF), Invalid: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"number":3,"base_type":"uint8","value":null}`; string(out) != expected {
+		t.Errorf("expected %s, got %s", expected, out)
+	}
+}
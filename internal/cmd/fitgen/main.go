@@ -0,0 +1,724 @@
+// Command fitgen generates the Global FIT Profile tables of package fit from
+// the Types and Messages sheets of the FIT SDK's Profile.xlsx, each exported
+// to CSV.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	typeNameMesgNum  = "mesg_num"
+	typeNameBaseType = "fit_base_type"
+
+	baseTypeNumberMask = 0x1F
+)
+
+// Columns of the Types sheet.
+const (
+	typesColName = iota
+	typesColBaseType
+	typesColValueName
+	typesColValue
+)
+
+// Columns of the Messages sheet.
+const (
+	messagesColName = iota
+	messagesColFieldNumber
+	messagesColFieldName
+	messagesColFieldType
+	messagesColArray
+	messagesColComponents
+	messagesColScale
+	messagesColOffset
+	messagesColUnits
+	messagesColBits
+	messagesColAccumulate
+	messagesColRefFieldName
+	messagesColRefFieldValue
+)
+
+type profileType struct {
+	name     string
+	baseType string
+	values   []typeValue
+}
+
+type typeValue struct {
+	name  string
+	value uint64
+}
+
+type profileMessage struct {
+	name   string
+	fields []*profileField
+}
+
+// profileField is a row of the Messages sheet. Subfields are rows without a
+// field number that follow the field they reinterpret.
+type profileField struct {
+	number     uint8
+	name       string
+	typ        string
+	array      bool
+	components []string
+	scale      []string
+	offset     []string
+	units      []string
+	bits       []string
+	accumulate []string
+	refNames   []string
+	refValues  []string
+	subfields  []*profileField
+}
+
+func main() {
+	typesPath := flag.String("types", "profile/Types.csv", "path of the Types sheet exported to CSV")
+	messagesPath := flag.String("messages", "profile/Messages.csv", "path of the Messages sheet exported to CSV")
+	out := flag.String("o", "profile_generated.go", "path of the generated profile tables")
+	structsOut := flag.String("structs", "messages_generated.go", "path of the generated message structs")
+	flag.Parse()
+
+	if err := run(*typesPath, *messagesPath, *out, *structsOut); err != nil {
+		fmt.Fprintln(os.Stderr, "fitgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(typesPath, messagesPath, out, structsOut string) error {
+	tf, err := os.Open(typesPath)
+	if err != nil {
+		return err
+	}
+	defer tf.Close()
+
+	types, err := readTypes(tf)
+	if err != nil {
+		return fmt.Errorf("%s: %w", typesPath, err)
+	}
+
+	mf, err := os.Open(messagesPath)
+	if err != nil {
+		return err
+	}
+	defer mf.Close()
+
+	messages, err := readMessages(mf)
+	if err != nil {
+		return fmt.Errorf("%s: %w", messagesPath, err)
+	}
+
+	profile, structs, err := generate(types, messages)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(out, profile, 0644); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(structsOut, structs, 0644)
+}
+
+// readRows reads every row of a sheet after its header row.
+func readRows(r io.Reader, columns int) ([][]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	rows = rows[1:]
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		for j := range row {
+			row[j] = strings.TrimSpace(row[j])
+		}
+		rows[i] = row
+	}
+	return rows, nil
+}
+
+func readTypes(r io.Reader) ([]*profileType, error) {
+	rows, err := readRows(r, typesColValue+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		types   []*profileType
+		current *profileType
+	)
+	for i, row := range rows {
+		switch {
+		case row[typesColName] != "":
+			current = &profileType{
+				name:     row[typesColName],
+				baseType: row[typesColBaseType],
+			}
+			types = append(types, current)
+		case row[typesColValueName] != "":
+			if current == nil {
+				return nil, fmt.Errorf("row %d: value %q precedes any type", i+2, row[typesColValueName])
+			}
+			v, err := strconv.ParseUint(row[typesColValue], 0, 64)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", i+2, err)
+			}
+			current.values = append(current.values, typeValue{name: row[typesColValueName], value: v})
+		}
+	}
+	return types, nil
+}
+
+func readMessages(r io.Reader) ([]*profileMessage, error) {
+	rows, err := readRows(r, messagesColRefFieldValue+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		messages []*profileMessage
+		current  *profileMessage
+		parent   *profileField
+	)
+	for i, row := range rows {
+		switch {
+		case row[messagesColName] != "":
+			current = &profileMessage{name: row[messagesColName]}
+			messages = append(messages, current)
+			parent = nil
+		case row[messagesColFieldName] != "":
+			if current == nil {
+				return nil, fmt.Errorf("row %d: field %q precedes any message", i+2, row[messagesColFieldName])
+			}
+
+			f := &profileField{
+				name:       row[messagesColFieldName],
+				typ:        row[messagesColFieldType],
+				array:      row[messagesColArray] != "",
+				components: splitList(row[messagesColComponents]),
+				scale:      splitList(row[messagesColScale]),
+				offset:     splitList(row[messagesColOffset]),
+				units:      splitList(row[messagesColUnits]),
+				bits:       splitList(row[messagesColBits]),
+				accumulate: splitList(row[messagesColAccumulate]),
+				refNames:   splitList(row[messagesColRefFieldName]),
+				refValues:  splitList(row[messagesColRefFieldValue]),
+			}
+
+			if row[messagesColFieldNumber] == "" {
+				if parent == nil {
+					return nil, fmt.Errorf("row %d: subfield %q precedes any field", i+2, f.name)
+				}
+				parent.subfields = append(parent.subfields, f)
+				continue
+			}
+
+			n, err := strconv.ParseUint(row[messagesColFieldNumber], 0, 8)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", i+2, err)
+			}
+			f.number = uint8(n)
+			current.fields = append(current.fields, f)
+			parent = f
+		}
+	}
+	return messages, nil
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	list := strings.Split(s, ",")
+	for i := range list {
+		list[i] = strings.TrimSpace(list[i])
+	}
+	return list
+}
+
+// camelCase converts a profile name such as three_d_sensor_calibration to
+// the ThreeDSensorCalibration form used in Go identifiers.
+func camelCase(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+	return b.String()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// generator resolves the names used by the Messages sheet against the Types
+// sheet while writing the generated source.
+type generator struct {
+	buf       bytes.Buffer
+	types     map[string]*profileType
+	baseTypes map[string]bool
+}
+
+func generate(types []*profileType, messages []*profileMessage) (profile, structs []byte, err error) {
+	g := &generator{
+		types:     make(map[string]*profileType, len(types)),
+		baseTypes: make(map[string]bool),
+	}
+	for _, t := range types {
+		if _, ok := g.types[t.name]; ok {
+			return nil, nil, fmt.Errorf("type %q is defined twice", t.name)
+		}
+		g.types[t.name] = t
+	}
+
+	baseTypes, ok := g.types[typeNameBaseType]
+	if !ok {
+		return nil, nil, fmt.Errorf("type %q is not defined", typeNameBaseType)
+	}
+	for _, v := range baseTypes.values {
+		g.baseTypes[v.name] = true
+	}
+
+	mesgNum, ok := g.types[typeNameMesgNum]
+	if !ok {
+		return nil, nil, fmt.Errorf("type %q is not defined", typeNameMesgNum)
+	}
+
+	g.printf("// Code generated by fitgen from the Global FIT Profile. DO NOT EDIT.\n\n")
+	g.printf("package fit\n\n")
+
+	g.generateBaseTypes(baseTypes)
+	g.generateMessageTypes(mesgNum)
+	if err := g.generateEnums(types); err != nil {
+		return nil, nil, err
+	}
+	if err := g.generateTypes(types); err != nil {
+		return nil, nil, err
+	}
+	if err := g.generateMessages(messages, mesgNum); err != nil {
+		return nil, nil, err
+	}
+	if profile, err = g.source(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := g.generateStructs(messages); err != nil {
+		return nil, nil, err
+	}
+	if structs, err = g.source(); err != nil {
+		return nil, nil, err
+	}
+	return profile, structs, nil
+}
+
+// source formats and returns everything written since the last call.
+func (g *generator) source() ([]byte, error) {
+	defer g.buf.Reset()
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w", err)
+	}
+	return src, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) generateBaseTypes(t *profileType) {
+	g.printf("const (\n")
+	for _, v := range t.values {
+		g.printf("BaseTypeNumber_%s = %d\n", camelCase(v.name), v.value&baseTypeNumberMask)
+	}
+	g.printf(")\n\n")
+}
+
+// messageTypeAliases are the names GlobalMessageType constants had before they
+// were generated, where they differ from the generated names, along with the
+// profile messages they name.
+var messageTypeAliases = []struct {
+	alias, message string
+}{
+	{"FileID", "file_id"},
+	{"FieldCapabilites", "field_capabilities"},
+}
+
+func (g *generator) generateMessageTypes(t *profileType) {
+	g.printf("const (\n")
+	for _, v := range t.values {
+		g.printf("GlobalMessageType_%s GlobalMessageType = %d\n", camelCase(v.name), v.value)
+	}
+	g.printf("GlobalMessageType_Unknown GlobalMessageType = 0xFFFF\n")
+	g.printf(")\n\n")
+
+	var aliases []string
+	for _, a := range messageTypeAliases {
+		for _, v := range t.values {
+			if v.name == a.message {
+				aliases = append(aliases, a.alias, camelCase(v.name))
+			}
+		}
+	}
+	if len(aliases) > 0 {
+		g.printf("const (\n")
+		for i := 0; i < len(aliases); i += 2 {
+			g.printf("// Deprecated: GlobalMessageType_%s is the name the constant had before it was generated. Use GlobalMessageType_%s.\n", aliases[i], aliases[i+1])
+			g.printf("GlobalMessageType_%s = GlobalMessageType_%s\n", aliases[i], aliases[i+1])
+		}
+		g.printf(")\n\n")
+	}
+
+	g.printf("var (\n")
+	g.printf("GlobalMessageNumber_Types = map[uint16]GlobalMessageType{\n")
+	for _, v := range t.values {
+		g.printf("%d: GlobalMessageType_%s,\n", v.value, camelCase(v.name))
+	}
+	g.printf("}\n\n")
+
+	g.printf("GlobalMessageType_Names = map[GlobalMessageType]string{\n")
+	for _, v := range t.values {
+		g.printf("GlobalMessageType_%s: %q,\n", camelCase(v.name), strings.ToUpper(v.name))
+	}
+	g.printf("GlobalMessageType_Unknown: \"UNKNOWN\",\n")
+	g.printf("}\n")
+	g.printf(")\n\n")
+}
+
+func (g *generator) generateEnums(types []*profileType) error {
+	for _, t := range types {
+		if !isEnum(t.name) {
+			continue
+		}
+
+		baseType, err := g.baseType(t.baseType)
+		if err != nil {
+			return fmt.Errorf("type %q: %w", t.name, err)
+		}
+		if strings.HasPrefix(baseType, "sint") {
+			return fmt.Errorf("type %q: signed base type %s", t.name, baseType)
+		}
+		name := goTypeName(t.name)
+
+		g.printf("// %s is the %s type of the Global FIT Profile.\n", name, t.name)
+		g.printf("type %s %s\n\n", name, goBaseTypes[baseType])
+		g.printf("const (\n")
+		for _, v := range t.values {
+			g.printf("%s_%s %s = %d\n", name, camelCase(v.name), name, v.value)
+		}
+		if !hasValue(t, invalidValues[baseType]) {
+			g.printf("%s_Invalid %s = %s\n", name, name, invalidLiterals[baseType])
+		}
+		g.printf(")\n\n")
+
+		g.printf("// String returns the profile name of v, or unknown_ followed by its value.\n")
+		g.printf("func (v %s) String() string {\n", name)
+		g.printf("return enumString(%q, uint64(v))\n", t.name)
+		g.printf("}\n\n")
+		g.printf("// MarshalJSON writes v as its profile name, or as a number when the\n")
+		g.printf("// profile does not name it.\n")
+		g.printf("func (v %s) MarshalJSON() ([]byte, error) {\n", name)
+		g.printf("return enumMarshalJSON(%q, uint64(v))\n", t.name)
+		g.printf("}\n\n")
+		g.printf("// UnmarshalJSON reads v from a profile name or a number.\n")
+		g.printf("func (v *%s) UnmarshalJSON(data []byte) error {\n", name)
+		g.printf("value, err := enumUnmarshalJSON(%q, data)\n", t.name)
+		g.printf("if err != nil {\n")
+		g.printf("return err\n")
+		g.printf("}\n")
+		g.printf("*v = %s(value)\n", name)
+		g.printf("return nil\n")
+		g.printf("}\n\n")
+	}
+	return nil
+}
+
+func hasValue(t *profileType, value uint64) bool {
+	for _, v := range t.values {
+		if v.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) generateTypes(types []*profileType) error {
+	g.printf("// ProfileTypes maps the name of each type of the Global FIT Profile to\n")
+	g.printf("// its definition.\n")
+	g.printf("var ProfileTypes = map[string]*ProfileType{\n")
+	for _, t := range types {
+		baseType, err := g.baseType(t.baseType)
+		if err != nil {
+			return fmt.Errorf("type %q: %w", t.name, err)
+		}
+
+		g.printf("%q: {\n", t.name)
+		g.printf("Name: %q,\n", t.name)
+		g.printf("BaseType: BaseTypeNumber_%s,\n", camelCase(baseType))
+		if isEnum(t.name) {
+			g.printf("Enum: true,\n")
+		}
+		if len(t.values) > 0 {
+			seen := make(map[uint64]string, len(t.values))
+			g.printf("Values: map[uint64]string{\n")
+			for _, v := range t.values {
+				if name, ok := seen[v.value]; ok {
+					return fmt.Errorf("type %q: values %q and %q are both %d", t.name, name, v.name, v.value)
+				}
+				seen[v.value] = v.name
+				g.printf("%d: %q,\n", v.value, v.name)
+			}
+			g.printf("},\n")
+		}
+		g.printf("},\n")
+	}
+	g.printf("}\n\n")
+	return nil
+}
+
+func (g *generator) generateMessages(messages []*profileMessage, mesgNum *profileType) error {
+	numbers := make(map[string]bool, len(mesgNum.values))
+	for _, v := range mesgNum.values {
+		numbers[v.name] = true
+	}
+
+	g.printf("// ProfileMessages maps each message of the Global FIT Profile to its\n")
+	g.printf("// definition.\n")
+	g.printf("var ProfileMessages = map[GlobalMessageType]*ProfileMessage{\n")
+	for _, m := range messages {
+		if !numbers[m.name] {
+			return fmt.Errorf("message %q is not a value of %q", m.name, typeNameMesgNum)
+		}
+
+		byName := make(map[string]*profileField, len(m.fields))
+		seen := make(map[uint8]bool, len(m.fields))
+		for _, f := range m.fields {
+			if seen[f.number] {
+				return fmt.Errorf("message %q: field number %d is defined twice", m.name, f.number)
+			}
+			seen[f.number] = true
+			byName[f.name] = f
+		}
+
+		g.printf("GlobalMessageType_%s: {\n", camelCase(m.name))
+		g.printf("Name: %q,\n", m.name)
+		g.printf("Type: GlobalMessageType_%s,\n", camelCase(m.name))
+		g.printf("Fields: map[uint8]*ProfileField{\n")
+		for _, f := range m.fields {
+			if err := g.generateField(f, byName); err != nil {
+				return fmt.Errorf("message %q: field %q: %w", m.name, f.name, err)
+			}
+		}
+		g.printf("},\n")
+		g.printf("},\n")
+	}
+	g.printf("}\n")
+	return nil
+}
+
+func (g *generator) generateField(f *profileField, byName map[string]*profileField) error {
+	g.printf("%d: {\n", f.number)
+	g.printf("Number: %d,\n", f.number)
+	g.printf("Name: %q,\n", f.name)
+	if err := g.generateValue(f); err != nil {
+		return err
+	}
+	if f.array {
+		g.printf("Array: true,\n")
+	}
+	if len(f.components) == 0 && listBool(f.accumulate, 0) {
+		g.printf("Accumulate: true,\n")
+	}
+	if err := g.generateComponents(f, byName); err != nil {
+		return err
+	}
+
+	if len(f.subfields) > 0 {
+		g.printf("Subfields: []ProfileSubfield{\n")
+		for _, s := range f.subfields {
+			g.printf("{\n")
+			g.printf("Name: %q,\n", s.name)
+			if err := g.generateValue(s); err != nil {
+				return fmt.Errorf("subfield %q: %w", s.name, err)
+			}
+			if err := g.generateComponents(s, byName); err != nil {
+				return fmt.Errorf("subfield %q: %w", s.name, err)
+			}
+			if err := g.generateReferences(s, byName); err != nil {
+				return fmt.Errorf("subfield %q: %w", s.name, err)
+			}
+			g.printf("},\n")
+		}
+		g.printf("},\n")
+	}
+	g.printf("},\n")
+	return nil
+}
+
+// generateValue writes the type, scale, offset and units of a field or
+// subfield. A field with a single component shares its scale, offset and
+// units with it; with several components those columns describe the
+// components only, and the field itself is left unscaled.
+func (g *generator) generateValue(f *profileField) error {
+	baseType, err := g.baseType(f.typ)
+	if err != nil {
+		return err
+	}
+
+	g.printf("Type: %q,\n", f.typ)
+	g.printf("BaseType: BaseTypeNumber_%s,\n", camelCase(baseType))
+
+	scale, offset, units := 1.0, 0.0, ""
+	if len(f.components) <= 1 {
+		if scale, err = listFloat(f.scale, 0, 1); err != nil {
+			return err
+		}
+		if offset, err = listFloat(f.offset, 0, 0); err != nil {
+			return err
+		}
+		units = listString(f.units, 0)
+	}
+	g.printf("Scale: %s,\n", formatFloat(scale))
+	if offset != 0 {
+		g.printf("Offset: %s,\n", formatFloat(offset))
+	}
+	if units != "" {
+		g.printf("Units: %q,\n", units)
+	}
+	return nil
+}
+
+func (g *generator) generateComponents(f *profileField, byName map[string]*profileField) error {
+	if len(f.components) == 0 {
+		return nil
+	}
+
+	g.printf("Components: []ProfileComponent{\n")
+	for i, name := range f.components {
+		target, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("component %q is not a field of the message", name)
+		}
+		scale, err := listFloat(f.scale, i, 1)
+		if err != nil {
+			return err
+		}
+		offset, err := listFloat(f.offset, i, 0)
+		if err != nil {
+			return err
+		}
+		bits, err := strconv.ParseUint(listString(f.bits, i), 10, 8)
+		if err != nil {
+			return fmt.Errorf("component %q: bits: %w", name, err)
+		}
+
+		g.printf("{\n")
+		g.printf("Number: %d,\n", target.number)
+		g.printf("Scale: %s,\n", formatFloat(scale))
+		if offset != 0 {
+			g.printf("Offset: %s,\n", formatFloat(offset))
+		}
+		if units := listString(f.units, i); units != "" {
+			g.printf("Units: %q,\n", units)
+		}
+		g.printf("Bits: %d,\n", bits)
+		if listBool(f.accumulate, i) {
+			g.printf("Accumulate: true,\n")
+		}
+		g.printf("},\n")
+	}
+	g.printf("},\n")
+	return nil
+}
+
+func (g *generator) generateReferences(s *profileField, byName map[string]*profileField) error {
+	if len(s.refNames) == 0 || len(s.refNames) != len(s.refValues) {
+		return fmt.Errorf("%d reference fields for %d reference values", len(s.refNames), len(s.refValues))
+	}
+
+	g.printf("References: []ProfileReference{\n")
+	for i, name := range s.refNames {
+		ref, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("reference %q is not a field of the message", name)
+		}
+		value, err := g.value(ref.typ, s.refValues[i])
+		if err != nil {
+			return fmt.Errorf("reference %q: %w", name, err)
+		}
+		g.printf("{Number: %d, Value: %d},\n", ref.number, value)
+	}
+	g.printf("},\n")
+	return nil
+}
+
+// baseType resolves the name of a base type or profile type to the name of
+// its base type. The profile types bool fields as enums.
+func (g *generator) baseType(name string) (string, error) {
+	if name == "bool" {
+		name = "enum"
+	}
+	if g.baseTypes[name] {
+		return name, nil
+	}
+	if t, ok := g.types[name]; ok {
+		return g.baseType(t.baseType)
+	}
+	return "", fmt.Errorf("type %q is not defined", name)
+}
+
+// value resolves a named value of a profile type, or parses a number for
+// fields of a plain base type.
+func (g *generator) value(typ, name string) (uint64, error) {
+	if t, ok := g.types[typ]; ok {
+		for _, v := range t.values {
+			if v.name == name {
+				return v.value, nil
+			}
+		}
+	}
+	v, err := strconv.ParseUint(name, 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a value of type %q", name, typ)
+	}
+	return v, nil
+}
+
+func listString(list []string, i int) string {
+	if i < len(list) {
+		return list[i]
+	}
+	return ""
+}
+
+func listFloat(list []string, i int, def float64) (float64, error) {
+	s := listString(list, i)
+	if s == "" {
+		return def, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func listBool(list []string, i int) bool {
+	return listString(list, i) == "1"
+}
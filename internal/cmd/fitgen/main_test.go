@@ -0,0 +1,118 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const testTypes = `Type Name,Base Type,Value Name,Value,Comment
+fit_base_type,uint8,,,
+,,enum,0x00,
+,,uint8,0x02,
+,,uint16,0x84,
+,,uint32,0x86,
+mesg_num,uint16,,,
+,,file_id,0,
+,,event,21,
+,,mfg_range_min,0xFF00,
+event,enum,,,
+,,timer,0,
+,,rear_gear_change,43,"marker, group 0"
+`
+
+const testMessages = `Message Name,Field Def #,Field Name,Field Type,Array,Components,Scale,Offset,Units,Bits,Accumulate,Ref Field Name,Ref Field Value,Comment
+event,,,,,,,,,,,,,
+,0,event,event,,,,,,,,,,
+,3,data,uint32,,,,,,,,,,
+,,gear_change_data,uint32,,"rear_gear_num,rear_gear","1,1",,,"8,8",,event,rear_gear_change,
+,11,rear_gear_num,uint8,,,,,,,,,,
+,12,rear_gear,uint8,,,,,,,,,,
+,253,timestamp,uint32,,,,,s,,,,,
+`
+
+func TestGenerate(t *testing.T) {
+	types, err := readTypes(strings.NewReader(testTypes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages, err := readMessages(strings.NewReader(testMessages))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 1 || len(messages[0].fields) != 5 || len(messages[0].fields[1].subfields) != 1 {
+		t.Fatalf("expected one message with five fields and one subfield, got %+v", messages)
+	}
+
+	src, structs, err := generate(types, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Compare without the alignment gofmt adds.
+	normalized := strings.Join(strings.Fields(string(src)), " ")
+	for _, want := range []string{
+		"BaseTypeNumber_Uint16 = 4",
+		"GlobalMessageType_MfgRangeMin GlobalMessageType = 65280",
+		"GlobalMessageType_FileID = GlobalMessageType_FileId",
+		`"EVENT",`,
+		`43: "rear_gear_change",`,
+		"{Number: 0, Value: 43},",
+		"Number: 11,",
+		"Enum: true,",
+		`return enumString("event", uint64(v))`,
+		"func (v *Event) UnmarshalJSON(data []byte) error {",
+	} {
+		if !strings.Contains(normalized, want) {
+			t.Errorf("expected generated source to contain %q", want)
+		}
+	}
+
+	normalized = strings.Join(strings.Fields(string(structs)), " ")
+	for _, want := range []string{
+		"type EventMsg struct {",
+		"Event Event",
+		"RearGear uint8",
+		"Event: 0xFF,",
+		"msg.Event = Event(v)",
+		"if m == nil || m.GlobalMessageType != GlobalMessageType_Event {",
+	} {
+		if !strings.Contains(normalized, want) {
+			t.Errorf("expected generated structs to contain %q", want)
+		}
+	}
+}
+
+func TestGenerateUndefined(t *testing.T) {
+	types, err := readTypes(strings.NewReader(testTypes))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, messages := range []string{
+		"Message Name\nlap,,,,\n,0,event,event\n",
+		"Message Name\nevent,,,,\n,0,event,sport\n",
+		"Message Name\nevent,,,,\n,3,data,uint32,,gear,1,,,8\n",
+		"Message Name\nevent,,,,\n,0,event,event\n,3,data,uint32\n,,timer_trigger,uint8,,,,,,,,event,lap\n",
+	} {
+		m, err := readMessages(strings.NewReader(messages))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := generate(types, m); err == nil {
+			t.Errorf("%q: expected error", messages)
+		}
+	}
+}
+
+func TestCamelCase(t *testing.T) {
+	for in, out := range map[string]string{
+		"file_id":                    "FileId",
+		"three_d_sensor_calibration": "ThreeDSensorCalibration",
+		"uint8z":                     "Uint8z",
+	} {
+		if got := camelCase(in); got != out {
+			t.Errorf("%s: expected %s, got %s", in, out, got)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	typeNameDateTime      = "date_time"
+	typeNameLocalDateTime = "local_date_time"
+
+	unitsSemicircles = "semicircles"
+
+	fieldNumberTimestamp = 253
+)
+
+// goBaseTypes maps each base type to the Go type of its values.
+var goBaseTypes = map[string]string{
+	"enum":    "uint8",
+	"sint8":   "int8",
+	"uint8":   "uint8",
+	"sint16":  "int16",
+	"uint16":  "uint16",
+	"sint32":  "int32",
+	"uint32":  "uint32",
+	"string":  "string",
+	"float32": "float32",
+	"float64": "float64",
+	"uint8z":  "uint8",
+	"uint16z": "uint16",
+	"uint32z": "uint32",
+	"byte":    "byte",
+	"sint64":  "int64",
+	"uint64":  "uint64",
+	"uint64z": "uint64",
+}
+
+// invalidValues holds the invalid sentinel of each integer base type.
+var invalidValues = map[string]uint64{
+	"enum":    0xFF,
+	"sint8":   0x7F,
+	"uint8":   0xFF,
+	"sint16":  0x7FFF,
+	"uint16":  0xFFFF,
+	"sint32":  0x7FFFFFFF,
+	"uint32":  0xFFFFFFFF,
+	"uint8z":  0x00,
+	"uint16z": 0x0000,
+	"uint32z": 0x00000000,
+	"sint64":  0x7FFFFFFFFFFFFFFF,
+	"uint64":  0xFFFFFFFFFFFFFFFF,
+	"uint64z": 0x0000000000000000,
+}
+
+var invalidLiterals = map[string]string{
+	"enum":    "0xFF",
+	"sint8":   "0x7F",
+	"uint8":   "0xFF",
+	"sint16":  "0x7FFF",
+	"uint16":  "0xFFFF",
+	"sint32":  "0x7FFFFFFF",
+	"uint32":  "0xFFFFFFFF",
+	"uint8z":  "0x00",
+	"uint16z": "0x0000",
+	"uint32z": "0x00000000",
+	"sint64":  "0x7FFFFFFFFFFFFFFF",
+	"uint64":  "0xFFFFFFFFFFFFFFFF",
+	"uint64z": "0x0000000000000000",
+}
+
+// renamedTypes avoids collisions between enum types and the other
+// identifiers of package fit.
+var renamedTypes = map[string]string{
+	"file": "FileType",
+}
+
+// isEnum reports whether a profile type becomes a Go type of its own. The
+// remaining types already have a Go representation.
+func isEnum(name string) bool {
+	switch name {
+	case typeNameMesgNum, typeNameBaseType, typeNameDateTime, typeNameLocalDateTime:
+		return false
+	}
+	return true
+}
+
+func goTypeName(name string) string {
+	if renamed, ok := renamedTypes[name]; ok {
+		return renamed
+	}
+	return camelCase(name)
+}
+
+// structField is a field of a generated message struct.
+type structField struct {
+	name    string
+	typ     string
+	comment string
+	// invalid is the value the field holds when the message lacks it, or
+	// empty when that is the zero value of typ.
+	invalid string
+	// assign is the code setting the field from f, a *Field.
+	assign string
+}
+
+func (g *generator) generateStructs(messages []*profileMessage) error {
+	var body strings.Builder
+	imports := map[string]bool{}
+
+	for _, m := range messages {
+		if len(m.fields) == 0 {
+			continue
+		}
+
+		fields := make([]structField, 0, len(m.fields))
+		for _, f := range m.fields {
+			sf, err := g.structField(f)
+			if err != nil {
+				return fmt.Errorf("message %q: field %q: %w", m.name, f.name, err)
+			}
+			fields = append(fields, sf)
+			for _, pkg := range []string{"math", "time"} {
+				if strings.Contains(sf.typ, pkg+".") || strings.Contains(sf.invalid, pkg+".") {
+					imports[pkg] = true
+				}
+			}
+		}
+
+		name := camelCase(m.name) + "Msg"
+		messageType := "GlobalMessageType_" + camelCase(m.name)
+
+		fmt.Fprintf(&body, "// %s is the %s message of the Global FIT Profile.\n", name, m.name)
+		fmt.Fprintf(&body, "type %s struct {\n", name)
+		for _, sf := range fields {
+			if sf.comment != "" {
+				fmt.Fprintf(&body, "%s %s // %s\n", sf.name, sf.typ, sf.comment)
+			} else {
+				fmt.Fprintf(&body, "%s %s\n", sf.name, sf.typ)
+			}
+		}
+		fmt.Fprintf(&body, "}\n\n")
+
+		fmt.Fprintf(&body, "// New%s returns a new %s with every field set to its invalid value.\n", name, name)
+		fmt.Fprintf(&body, "func New%s() %s {\n", name, name)
+		fmt.Fprintf(&body, "return %s{\n", name)
+		for _, sf := range fields {
+			if sf.invalid != "" {
+				fmt.Fprintf(&body, "%s: %s,\n", sf.name, sf.invalid)
+			}
+		}
+		fmt.Fprintf(&body, "}\n")
+		fmt.Fprintf(&body, "}\n\n")
+
+		fmt.Fprintf(&body, "// Unmarshal sets the fields of the message from a decoded %s data\n", m.name)
+		fmt.Fprintf(&body, "// message. Fields missing from m are set to their invalid value.\n")
+		fmt.Fprintf(&body, "func (msg *%s) Unmarshal(m *DataMessage) error {\n", name)
+		fmt.Fprintf(&body, "if m == nil || m.GlobalMessageType != %s {\n", messageType)
+		fmt.Fprintf(&body, "return ErrorGlobalMessageTypeMismatch\n")
+		fmt.Fprintf(&body, "}\n\n")
+		fmt.Fprintf(&body, "*msg = New%s()\n", name)
+		if hasTimestamp(m) {
+			// Messages with a compressed timestamp header have no
+			// timestamp field of their own.
+			fmt.Fprintf(&body, "if m.Timestamp != nil {\n")
+			fmt.Fprintf(&body, "msg.Timestamp = TimeFromDateTime(*m.Timestamp)\n")
+			fmt.Fprintf(&body, "}\n")
+		}
+		fmt.Fprintf(&body, "for i := range m.Fields {\n")
+		fmt.Fprintf(&body, "f := &m.Fields[i]\n")
+		fmt.Fprintf(&body, "switch f.Number {\n")
+		for i, sf := range fields {
+			fmt.Fprintf(&body, "case %d:\n", m.fields[i].number)
+			fmt.Fprintf(&body, "%s\n", strings.Replace(sf.assign, "msg.X", "msg."+sf.name, -1))
+		}
+		fmt.Fprintf(&body, "}\n")
+		fmt.Fprintf(&body, "}\n")
+		fmt.Fprintf(&body, "return nil\n")
+		fmt.Fprintf(&body, "}\n\n")
+	}
+
+	g.printf("// Code generated by fitgen from the Global FIT Profile. DO NOT EDIT.\n\n")
+	g.printf("package fit\n\n")
+	if len(imports) > 0 {
+		g.printf("import (\n")
+		for _, pkg := range []string{"math", "time"} {
+			if imports[pkg] {
+				g.printf("%q\n", pkg)
+			}
+		}
+		g.printf(")\n\n")
+	}
+	g.buf.WriteString(body.String())
+	return nil
+}
+
+// hasTimestamp reports whether the message has a date_time timestamp field,
+// which the decoder also resolves from compressed timestamp headers.
+func hasTimestamp(m *profileMessage) bool {
+	for _, f := range m.fields {
+		if f.number == fieldNumberTimestamp && f.name == "timestamp" && f.typ == typeNameDateTime {
+			return true
+		}
+	}
+	return false
+}
+
+// structField chooses the Go representation of a profile field: times for
+// date_time fields, degrees for semicircles, float64 for scaled fields and
+// the enum or integer type of the field otherwise.
+func (g *generator) structField(f *profileField) (structField, error) {
+	baseType, err := g.baseType(f.typ)
+	if err != nil {
+		return structField{}, err
+	}
+
+	sf := structField{name: camelCase(f.name)}
+
+	var (
+		scale, offset = 1.0, 0.0
+		units         string
+	)
+	if len(f.components) <= 1 {
+		if scale, err = listFloat(f.scale, 0, 1); err != nil {
+			return structField{}, err
+		}
+		if offset, err = listFloat(f.offset, 0, 0); err != nil {
+			return structField{}, err
+		}
+		units = listString(f.units, 0)
+	}
+
+	switch {
+	case f.typ == "bool":
+		sf.typ = "bool"
+		sf.assign = "if v, ok := f.Uint64(); ok {\nmsg.X = v != 0\n}"
+	case goBaseTypes[baseType] == "string":
+		sf.typ = "string"
+		sf.assign = "if v, ok := f.Value.(string); ok && !f.Invalid {\nmsg.X = v\n}"
+	case goBaseTypes[baseType] == "byte":
+		sf.typ = "[]byte"
+		sf.assign = "if v, ok := f.Value.([]byte); ok && !f.Invalid {\nmsg.X = v\n}"
+	case (f.typ == typeNameDateTime || f.typ == typeNameLocalDateTime) && !f.array:
+		sf.typ = "time.Time"
+		sf.assign = "if v, ok := f.Uint64(); ok {\nmsg.X = TimeFromDateTime(uint32(v))\n}"
+		if f.typ == typeNameLocalDateTime {
+			sf.comment = "local time, in UTC"
+			sf.assign = "if v, ok := f.Uint64(); ok {\nmsg.X = TimeFromLocalDateTime(uint32(v), time.UTC)\n}"
+		}
+	case units == unitsSemicircles && !f.array:
+		sf.typ = "float64"
+		sf.comment = "degrees"
+		sf.invalid = "math.NaN()"
+		sf.assign = "if v, ok := f.Int64(); ok {\nmsg.X = DegreesFromSemicircles(int32(v))\n}"
+	case scale != 1 || offset != 0:
+		sf.comment = units
+		if f.array {
+			sf.typ = "[]float64"
+			sf.assign = fmt.Sprintf("msg.X = fieldScaledFloats(f, %s, %s)", formatFloat(scale), formatFloat(offset))
+		} else {
+			sf.typ = "float64"
+			sf.invalid = "math.NaN()"
+			sf.assign = fmt.Sprintf("if v, ok := fieldScaled(f, %s, %s); ok {\nmsg.X = v\n}", formatFloat(scale), formatFloat(offset))
+		}
+	default:
+		sf.comment = units
+
+		typ := goBaseTypes[baseType]
+		switch {
+		case f.typ == typeNameMesgNum:
+			typ = "GlobalMessageType"
+		case isEnum(f.typ) && !g.baseTypes[f.typ]:
+			typ = goTypeName(f.typ)
+		}
+
+		var value, values string
+		switch baseType {
+		case "float32", "float64":
+			value, values = "float64", "fieldFloats"
+		case "sint8", "sint16", "sint32", "sint64":
+			value, values = "int64", "fieldInts"
+		default:
+			value, values = "uint64", "fieldUints"
+		}
+
+		if f.array {
+			sf.typ = "[]" + typ
+			sf.assign = fmt.Sprintf("for _, v := range %s(f) {\nmsg.X = append(msg.X, %s(v))\n}", values, typ)
+		} else {
+			sf.typ = typ
+			sf.assign = fmt.Sprintf("if v, ok := f.Value.(%s); ok {\nmsg.X = %s(v)\n}", value, typ)
+			switch baseType {
+			case "float32", "float64":
+				sf.invalid = baseType + "(math.NaN())"
+			default:
+				if invalidValues[baseType] != 0 {
+					sf.invalid = invalidLiterals[baseType]
+				}
+			}
+		}
+	}
+	return sf, nil
+}
@@ -0,0 +1,74 @@
+package fit
+
+import "math"
+
+// fieldUints returns the elements of an unsigned array field. Arrays holding
+// a single element decode to a scalar, which is returned as a slice too.
+func fieldUints(f *Field) []uint64 {
+	if f.Invalid {
+		return nil
+	}
+	switch v := f.Value.(type) {
+	case uint64:
+		return []uint64{v}
+	case []uint64:
+		return v
+	}
+	return nil
+}
+
+// fieldInts is like fieldUints for signed array fields.
+func fieldInts(f *Field) []int64 {
+	if f.Invalid {
+		return nil
+	}
+	switch v := f.Value.(type) {
+	case int64:
+		return []int64{v}
+	case []int64:
+		return v
+	}
+	return nil
+}
+
+// fieldFloats is like fieldUints for floating point array fields.
+func fieldFloats(f *Field) []float64 {
+	if f.Invalid {
+		return nil
+	}
+	switch v := f.Value.(type) {
+	case float64:
+		return []float64{v}
+	case []float64:
+		return v
+	}
+	return nil
+}
+
+// fieldScaled returns the value of a scalar field with the scale and offset
+// of the profile field applied. These are passed in, rather than taken from
+// f, as a subfield resolved while decoding can have a scale of its own.
+func fieldScaled(f *Field, scale, offset float64) (float64, bool) {
+	parent := *f
+	parent.Scale, parent.Offset = scale, offset
+	return parent.Scaled()
+}
+
+// fieldScaledFloats is like fieldScaled for array fields.
+func fieldScaledFloats(f *Field, scale, offset float64) []float64 {
+	if f.Invalid {
+		return nil
+	}
+	parent := *f
+	parent.Scale, parent.Offset = scale, offset
+	switch v := parent.ScaledValue().(type) {
+	case float64:
+		if math.IsNaN(v) {
+			return nil
+		}
+		return []float64{v}
+	case []float64:
+		return v
+	}
+	return nil
+}
@@ -0,0 +1,2017 @@
+// Code generated by fitgen from the Global FIT Profile. DO NOT EDIT.
+
+package fit
+
+import (
+	"math"
+	"time"
+)
+
+// FileIdMsg is the file_id message of the Global FIT Profile.
+type FileIdMsg struct {
+	Type         FileType
+	Manufacturer Manufacturer
+	Product      uint16
+	SerialNumber uint32
+	TimeCreated  time.Time
+	Number       uint16
+	ProductName  string
+}
+
+// NewFileIdMsg returns a new FileIdMsg with every field set to its invalid value.
+func NewFileIdMsg() FileIdMsg {
+	return FileIdMsg{
+		Type:         0xFF,
+		Manufacturer: 0xFFFF,
+		Product:      0xFFFF,
+		Number:       0xFFFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded file_id data
+// message. Fields missing from m are set to their invalid value.
+func (msg *FileIdMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_FileId {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewFileIdMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Type = FileType(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Manufacturer = Manufacturer(v)
+			}
+		case 2:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Product = uint16(v)
+			}
+		case 3:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SerialNumber = uint32(v)
+			}
+		case 4:
+			if v, ok := f.Uint64(); ok {
+				msg.TimeCreated = TimeFromDateTime(uint32(v))
+			}
+		case 5:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Number = uint16(v)
+			}
+		case 8:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.ProductName = v
+			}
+		}
+	}
+	return nil
+}
+
+// FileCreatorMsg is the file_creator message of the Global FIT Profile.
+type FileCreatorMsg struct {
+	SoftwareVersion uint16
+	HardwareVersion uint8
+}
+
+// NewFileCreatorMsg returns a new FileCreatorMsg with every field set to its invalid value.
+func NewFileCreatorMsg() FileCreatorMsg {
+	return FileCreatorMsg{
+		SoftwareVersion: 0xFFFF,
+		HardwareVersion: 0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded file_creator data
+// message. Fields missing from m are set to their invalid value.
+func (msg *FileCreatorMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_FileCreator {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewFileCreatorMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SoftwareVersion = uint16(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.HardwareVersion = uint8(v)
+			}
+		}
+	}
+	return nil
+}
+
+// SoftwareMsg is the software message of the Global FIT Profile.
+type SoftwareMsg struct {
+	MessageIndex MessageIndex
+	Version      float64
+	PartNumber   string
+}
+
+// NewSoftwareMsg returns a new SoftwareMsg with every field set to its invalid value.
+func NewSoftwareMsg() SoftwareMsg {
+	return SoftwareMsg{
+		MessageIndex: 0xFFFF,
+		Version:      math.NaN(),
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded software data
+// message. Fields missing from m are set to their invalid value.
+func (msg *SoftwareMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Software {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewSoftwareMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 254:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MessageIndex = MessageIndex(v)
+			}
+		case 3:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.Version = v
+			}
+		case 5:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.PartNumber = v
+			}
+		}
+	}
+	return nil
+}
+
+// UserProfileMsg is the user_profile message of the Global FIT Profile.
+type UserProfileMsg struct {
+	MessageIndex        MessageIndex
+	FriendlyName        string
+	Gender              Gender
+	Age                 uint8   // years
+	Height              float64 // m
+	Weight              float64 // kg
+	RestingHeartRate    uint8   // bpm
+	DefaultMaxHeartRate uint8   // bpm
+}
+
+// NewUserProfileMsg returns a new UserProfileMsg with every field set to its invalid value.
+func NewUserProfileMsg() UserProfileMsg {
+	return UserProfileMsg{
+		MessageIndex:        0xFFFF,
+		Gender:              0xFF,
+		Age:                 0xFF,
+		Height:              math.NaN(),
+		Weight:              math.NaN(),
+		RestingHeartRate:    0xFF,
+		DefaultMaxHeartRate: 0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded user_profile data
+// message. Fields missing from m are set to their invalid value.
+func (msg *UserProfileMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_UserProfile {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewUserProfileMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 254:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MessageIndex = MessageIndex(v)
+			}
+		case 0:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.FriendlyName = v
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Gender = Gender(v)
+			}
+		case 2:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Age = uint8(v)
+			}
+		case 3:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.Height = v
+			}
+		case 4:
+			if v, ok := fieldScaled(f, 10, 0); ok {
+				msg.Weight = v
+			}
+		case 8:
+			if v, ok := f.Value.(uint64); ok {
+				msg.RestingHeartRate = uint8(v)
+			}
+		case 11:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DefaultMaxHeartRate = uint8(v)
+			}
+		}
+	}
+	return nil
+}
+
+// SportMsg is the sport message of the Global FIT Profile.
+type SportMsg struct {
+	Sport    Sport
+	SubSport SubSport
+	Name     string
+}
+
+// NewSportMsg returns a new SportMsg with every field set to its invalid value.
+func NewSportMsg() SportMsg {
+	return SportMsg{
+		Sport:    0xFF,
+		SubSport: 0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded sport data
+// message. Fields missing from m are set to their invalid value.
+func (msg *SportMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Sport {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewSportMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Sport = Sport(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SubSport = SubSport(v)
+			}
+		case 3:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.Name = v
+			}
+		}
+	}
+	return nil
+}
+
+// WorkoutMsg is the workout message of the Global FIT Profile.
+type WorkoutMsg struct {
+	Sport          Sport
+	NumValidSteps  uint16
+	WktName        string
+	SubSport       SubSport
+	PoolLength     float64 // m
+	PoolLengthUnit DisplayMeasure
+}
+
+// NewWorkoutMsg returns a new WorkoutMsg with every field set to its invalid value.
+func NewWorkoutMsg() WorkoutMsg {
+	return WorkoutMsg{
+		Sport:          0xFF,
+		NumValidSteps:  0xFFFF,
+		SubSport:       0xFF,
+		PoolLength:     math.NaN(),
+		PoolLengthUnit: 0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded workout data
+// message. Fields missing from m are set to their invalid value.
+func (msg *WorkoutMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Workout {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewWorkoutMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 4:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Sport = Sport(v)
+			}
+		case 6:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NumValidSteps = uint16(v)
+			}
+		case 8:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.WktName = v
+			}
+		case 11:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SubSport = SubSport(v)
+			}
+		case 14:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.PoolLength = v
+			}
+		case 15:
+			if v, ok := f.Value.(uint64); ok {
+				msg.PoolLengthUnit = DisplayMeasure(v)
+			}
+		}
+	}
+	return nil
+}
+
+// WorkoutStepMsg is the workout_step message of the Global FIT Profile.
+type WorkoutStepMsg struct {
+	MessageIndex          MessageIndex
+	WktStepName           string
+	DurationType          WktStepDuration
+	DurationValue         uint32
+	TargetType            WktStepTarget
+	TargetValue           uint32
+	CustomTargetValueLow  uint32
+	CustomTargetValueHigh uint32
+	Intensity             Intensity
+	Notes                 string
+}
+
+// NewWorkoutStepMsg returns a new WorkoutStepMsg with every field set to its invalid value.
+func NewWorkoutStepMsg() WorkoutStepMsg {
+	return WorkoutStepMsg{
+		MessageIndex:          0xFFFF,
+		DurationType:          0xFF,
+		DurationValue:         0xFFFFFFFF,
+		TargetType:            0xFF,
+		TargetValue:           0xFFFFFFFF,
+		CustomTargetValueLow:  0xFFFFFFFF,
+		CustomTargetValueHigh: 0xFFFFFFFF,
+		Intensity:             0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded workout_step data
+// message. Fields missing from m are set to their invalid value.
+func (msg *WorkoutStepMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_WorkoutStep {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewWorkoutStepMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 254:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MessageIndex = MessageIndex(v)
+			}
+		case 0:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.WktStepName = v
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DurationType = WktStepDuration(v)
+			}
+		case 2:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DurationValue = uint32(v)
+			}
+		case 3:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TargetType = WktStepTarget(v)
+			}
+		case 4:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TargetValue = uint32(v)
+			}
+		case 5:
+			if v, ok := f.Value.(uint64); ok {
+				msg.CustomTargetValueLow = uint32(v)
+			}
+		case 6:
+			if v, ok := f.Value.(uint64); ok {
+				msg.CustomTargetValueHigh = uint32(v)
+			}
+		case 7:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Intensity = Intensity(v)
+			}
+		case 8:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.Notes = v
+			}
+		}
+	}
+	return nil
+}
+
+// ActivityMsg is the activity message of the Global FIT Profile.
+type ActivityMsg struct {
+	Timestamp      time.Time
+	TotalTimerTime float64 // s
+	NumSessions    uint16
+	Type           Activity
+	Event          Event
+	EventType      EventType
+	LocalTimestamp time.Time // local time, in UTC
+	EventGroup     uint8
+}
+
+// NewActivityMsg returns a new ActivityMsg with every field set to its invalid value.
+func NewActivityMsg() ActivityMsg {
+	return ActivityMsg{
+		TotalTimerTime: math.NaN(),
+		NumSessions:    0xFFFF,
+		Type:           0xFF,
+		Event:          0xFF,
+		EventType:      0xFF,
+		EventGroup:     0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded activity data
+// message. Fields missing from m are set to their invalid value.
+func (msg *ActivityMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Activity {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewActivityMsg()
+	if m.Timestamp != nil {
+		msg.Timestamp = TimeFromDateTime(*m.Timestamp)
+	}
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 253:
+			if v, ok := f.Uint64(); ok {
+				msg.Timestamp = TimeFromDateTime(uint32(v))
+			}
+		case 0:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.TotalTimerTime = v
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NumSessions = uint16(v)
+			}
+		case 2:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Type = Activity(v)
+			}
+		case 3:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Event = Event(v)
+			}
+		case 4:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventType = EventType(v)
+			}
+		case 5:
+			if v, ok := f.Uint64(); ok {
+				msg.LocalTimestamp = TimeFromLocalDateTime(uint32(v), time.UTC)
+			}
+		case 6:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventGroup = uint8(v)
+			}
+		}
+	}
+	return nil
+}
+
+// SessionMsg is the session message of the Global FIT Profile.
+type SessionMsg struct {
+	MessageIndex                 MessageIndex
+	Timestamp                    time.Time
+	Event                        Event
+	EventType                    EventType
+	StartTime                    time.Time
+	StartPositionLat             float64 // degrees
+	StartPositionLong            float64 // degrees
+	Sport                        Sport
+	SubSport                     SubSport
+	TotalElapsedTime             float64 // s
+	TotalTimerTime               float64 // s
+	TotalDistance                float64 // m
+	TotalCycles                  uint32  // cycles
+	TotalCalories                uint16  // kcal
+	TotalFatCalories             uint16  // kcal
+	AvgSpeed                     float64 // m/s
+	MaxSpeed                     float64 // m/s
+	AvgHeartRate                 uint8   // bpm
+	MaxHeartRate                 uint8   // bpm
+	AvgCadence                   uint8   // rpm
+	MaxCadence                   uint8   // rpm
+	AvgPower                     uint16  // watts
+	MaxPower                     uint16  // watts
+	TotalAscent                  uint16  // m
+	TotalDescent                 uint16  // m
+	TotalTrainingEffect          float64
+	FirstLapIndex                uint16
+	NumLaps                      uint16
+	EventGroup                   uint8
+	Trigger                      SessionTrigger
+	NecLat                       float64    // degrees
+	NecLong                      float64    // degrees
+	SwcLat                       float64    // degrees
+	SwcLong                      float64    // degrees
+	NumLengths                   uint16     // lengths
+	NormalizedPower              uint16     // watts
+	TrainingStressScore          float64    // tss
+	IntensityFactor              float64    // if
+	SwimStroke                   SwimStroke // swim_stroke
+	PoolLength                   float64    // m
+	ThresholdPower               uint16     // watts
+	PoolLengthUnit               DisplayMeasure
+	NumActiveLengths             uint16  // lengths
+	TotalWork                    uint32  // J
+	AvgAltitude                  float64 // m
+	MaxAltitude                  float64 // m
+	MinAltitude                  float64 // m
+	SportProfileName             string
+	EnhancedAvgSpeed             float64 // m/s
+	EnhancedMaxSpeed             float64 // m/s
+	EnhancedAvgAltitude          float64 // m
+	EnhancedMinAltitude          float64 // m
+	EnhancedMaxAltitude          float64 // m
+	TotalAnaerobicTrainingEffect float64
+}
+
+// NewSessionMsg returns a new SessionMsg with every field set to its invalid value.
+func NewSessionMsg() SessionMsg {
+	return SessionMsg{
+		MessageIndex:                 0xFFFF,
+		Event:                        0xFF,
+		EventType:                    0xFF,
+		StartPositionLat:             math.NaN(),
+		StartPositionLong:            math.NaN(),
+		Sport:                        0xFF,
+		SubSport:                     0xFF,
+		TotalElapsedTime:             math.NaN(),
+		TotalTimerTime:               math.NaN(),
+		TotalDistance:                math.NaN(),
+		TotalCycles:                  0xFFFFFFFF,
+		TotalCalories:                0xFFFF,
+		TotalFatCalories:             0xFFFF,
+		AvgSpeed:                     math.NaN(),
+		MaxSpeed:                     math.NaN(),
+		AvgHeartRate:                 0xFF,
+		MaxHeartRate:                 0xFF,
+		AvgCadence:                   0xFF,
+		MaxCadence:                   0xFF,
+		AvgPower:                     0xFFFF,
+		MaxPower:                     0xFFFF,
+		TotalAscent:                  0xFFFF,
+		TotalDescent:                 0xFFFF,
+		TotalTrainingEffect:          math.NaN(),
+		FirstLapIndex:                0xFFFF,
+		NumLaps:                      0xFFFF,
+		EventGroup:                   0xFF,
+		Trigger:                      0xFF,
+		NecLat:                       math.NaN(),
+		NecLong:                      math.NaN(),
+		SwcLat:                       math.NaN(),
+		SwcLong:                      math.NaN(),
+		NumLengths:                   0xFFFF,
+		NormalizedPower:              0xFFFF,
+		TrainingStressScore:          math.NaN(),
+		IntensityFactor:              math.NaN(),
+		SwimStroke:                   0xFF,
+		PoolLength:                   math.NaN(),
+		ThresholdPower:               0xFFFF,
+		PoolLengthUnit:               0xFF,
+		NumActiveLengths:             0xFFFF,
+		TotalWork:                    0xFFFFFFFF,
+		AvgAltitude:                  math.NaN(),
+		MaxAltitude:                  math.NaN(),
+		MinAltitude:                  math.NaN(),
+		EnhancedAvgSpeed:             math.NaN(),
+		EnhancedMaxSpeed:             math.NaN(),
+		EnhancedAvgAltitude:          math.NaN(),
+		EnhancedMinAltitude:          math.NaN(),
+		EnhancedMaxAltitude:          math.NaN(),
+		TotalAnaerobicTrainingEffect: math.NaN(),
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded session data
+// message. Fields missing from m are set to their invalid value.
+func (msg *SessionMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Session {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewSessionMsg()
+	if m.Timestamp != nil {
+		msg.Timestamp = TimeFromDateTime(*m.Timestamp)
+	}
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 254:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MessageIndex = MessageIndex(v)
+			}
+		case 253:
+			if v, ok := f.Uint64(); ok {
+				msg.Timestamp = TimeFromDateTime(uint32(v))
+			}
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Event = Event(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventType = EventType(v)
+			}
+		case 2:
+			if v, ok := f.Uint64(); ok {
+				msg.StartTime = TimeFromDateTime(uint32(v))
+			}
+		case 3:
+			if v, ok := f.Int64(); ok {
+				msg.StartPositionLat = DegreesFromSemicircles(int32(v))
+			}
+		case 4:
+			if v, ok := f.Int64(); ok {
+				msg.StartPositionLong = DegreesFromSemicircles(int32(v))
+			}
+		case 5:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Sport = Sport(v)
+			}
+		case 6:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SubSport = SubSport(v)
+			}
+		case 7:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.TotalElapsedTime = v
+			}
+		case 8:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.TotalTimerTime = v
+			}
+		case 9:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.TotalDistance = v
+			}
+		case 10:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalCycles = uint32(v)
+			}
+		case 11:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalCalories = uint16(v)
+			}
+		case 13:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalFatCalories = uint16(v)
+			}
+		case 14:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.AvgSpeed = v
+			}
+		case 15:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.MaxSpeed = v
+			}
+		case 16:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AvgHeartRate = uint8(v)
+			}
+		case 17:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MaxHeartRate = uint8(v)
+			}
+		case 18:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AvgCadence = uint8(v)
+			}
+		case 19:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MaxCadence = uint8(v)
+			}
+		case 20:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AvgPower = uint16(v)
+			}
+		case 21:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MaxPower = uint16(v)
+			}
+		case 22:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalAscent = uint16(v)
+			}
+		case 23:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalDescent = uint16(v)
+			}
+		case 24:
+			if v, ok := fieldScaled(f, 10, 0); ok {
+				msg.TotalTrainingEffect = v
+			}
+		case 25:
+			if v, ok := f.Value.(uint64); ok {
+				msg.FirstLapIndex = uint16(v)
+			}
+		case 26:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NumLaps = uint16(v)
+			}
+		case 27:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventGroup = uint8(v)
+			}
+		case 28:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Trigger = SessionTrigger(v)
+			}
+		case 29:
+			if v, ok := f.Int64(); ok {
+				msg.NecLat = DegreesFromSemicircles(int32(v))
+			}
+		case 30:
+			if v, ok := f.Int64(); ok {
+				msg.NecLong = DegreesFromSemicircles(int32(v))
+			}
+		case 31:
+			if v, ok := f.Int64(); ok {
+				msg.SwcLat = DegreesFromSemicircles(int32(v))
+			}
+		case 32:
+			if v, ok := f.Int64(); ok {
+				msg.SwcLong = DegreesFromSemicircles(int32(v))
+			}
+		case 33:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NumLengths = uint16(v)
+			}
+		case 34:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NormalizedPower = uint16(v)
+			}
+		case 35:
+			if v, ok := fieldScaled(f, 10, 0); ok {
+				msg.TrainingStressScore = v
+			}
+		case 36:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.IntensityFactor = v
+			}
+		case 43:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SwimStroke = SwimStroke(v)
+			}
+		case 44:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.PoolLength = v
+			}
+		case 45:
+			if v, ok := f.Value.(uint64); ok {
+				msg.ThresholdPower = uint16(v)
+			}
+		case 46:
+			if v, ok := f.Value.(uint64); ok {
+				msg.PoolLengthUnit = DisplayMeasure(v)
+			}
+		case 47:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NumActiveLengths = uint16(v)
+			}
+		case 48:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalWork = uint32(v)
+			}
+		case 49:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.AvgAltitude = v
+			}
+		case 50:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.MaxAltitude = v
+			}
+		case 71:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.MinAltitude = v
+			}
+		case 110:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.SportProfileName = v
+			}
+		case 124:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.EnhancedAvgSpeed = v
+			}
+		case 125:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.EnhancedMaxSpeed = v
+			}
+		case 126:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.EnhancedAvgAltitude = v
+			}
+		case 127:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.EnhancedMinAltitude = v
+			}
+		case 128:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.EnhancedMaxAltitude = v
+			}
+		case 137:
+			if v, ok := fieldScaled(f, 10, 0); ok {
+				msg.TotalAnaerobicTrainingEffect = v
+			}
+		}
+	}
+	return nil
+}
+
+// LapMsg is the lap message of the Global FIT Profile.
+type LapMsg struct {
+	MessageIndex        MessageIndex
+	Timestamp           time.Time
+	Event               Event
+	EventType           EventType
+	StartTime           time.Time
+	StartPositionLat    float64 // degrees
+	StartPositionLong   float64 // degrees
+	EndPositionLat      float64 // degrees
+	EndPositionLong     float64 // degrees
+	TotalElapsedTime    float64 // s
+	TotalTimerTime      float64 // s
+	TotalDistance       float64 // m
+	TotalCycles         uint32  // cycles
+	TotalCalories       uint16  // kcal
+	TotalFatCalories    uint16  // kcal
+	AvgSpeed            float64 // m/s
+	MaxSpeed            float64 // m/s
+	AvgHeartRate        uint8   // bpm
+	MaxHeartRate        uint8   // bpm
+	AvgCadence          uint8   // rpm
+	MaxCadence          uint8   // rpm
+	AvgPower            uint16  // watts
+	MaxPower            uint16  // watts
+	TotalAscent         uint16  // m
+	TotalDescent        uint16  // m
+	Intensity           Intensity
+	LapTrigger          LapTrigger
+	Sport               Sport
+	EventGroup          uint8
+	NumLengths          uint16 // lengths
+	NormalizedPower     uint16 // watts
+	FirstLengthIndex    uint16
+	SwimStroke          SwimStroke // swim_stroke
+	SubSport            SubSport
+	NumActiveLengths    uint16  // lengths
+	TotalWork           uint32  // J
+	AvgAltitude         float64 // m
+	MaxAltitude         float64 // m
+	MinAltitude         float64 // m
+	EnhancedAvgSpeed    float64 // m/s
+	EnhancedMaxSpeed    float64 // m/s
+	EnhancedAvgAltitude float64 // m
+	EnhancedMinAltitude float64 // m
+	EnhancedMaxAltitude float64 // m
+}
+
+// NewLapMsg returns a new LapMsg with every field set to its invalid value.
+func NewLapMsg() LapMsg {
+	return LapMsg{
+		MessageIndex:        0xFFFF,
+		Event:               0xFF,
+		EventType:           0xFF,
+		StartPositionLat:    math.NaN(),
+		StartPositionLong:   math.NaN(),
+		EndPositionLat:      math.NaN(),
+		EndPositionLong:     math.NaN(),
+		TotalElapsedTime:    math.NaN(),
+		TotalTimerTime:      math.NaN(),
+		TotalDistance:       math.NaN(),
+		TotalCycles:         0xFFFFFFFF,
+		TotalCalories:       0xFFFF,
+		TotalFatCalories:    0xFFFF,
+		AvgSpeed:            math.NaN(),
+		MaxSpeed:            math.NaN(),
+		AvgHeartRate:        0xFF,
+		MaxHeartRate:        0xFF,
+		AvgCadence:          0xFF,
+		MaxCadence:          0xFF,
+		AvgPower:            0xFFFF,
+		MaxPower:            0xFFFF,
+		TotalAscent:         0xFFFF,
+		TotalDescent:        0xFFFF,
+		Intensity:           0xFF,
+		LapTrigger:          0xFF,
+		Sport:               0xFF,
+		EventGroup:          0xFF,
+		NumLengths:          0xFFFF,
+		NormalizedPower:     0xFFFF,
+		FirstLengthIndex:    0xFFFF,
+		SwimStroke:          0xFF,
+		SubSport:            0xFF,
+		NumActiveLengths:    0xFFFF,
+		TotalWork:           0xFFFFFFFF,
+		AvgAltitude:         math.NaN(),
+		MaxAltitude:         math.NaN(),
+		MinAltitude:         math.NaN(),
+		EnhancedAvgSpeed:    math.NaN(),
+		EnhancedMaxSpeed:    math.NaN(),
+		EnhancedAvgAltitude: math.NaN(),
+		EnhancedMinAltitude: math.NaN(),
+		EnhancedMaxAltitude: math.NaN(),
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded lap data
+// message. Fields missing from m are set to their invalid value.
+func (msg *LapMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Lap {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewLapMsg()
+	if m.Timestamp != nil {
+		msg.Timestamp = TimeFromDateTime(*m.Timestamp)
+	}
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 254:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MessageIndex = MessageIndex(v)
+			}
+		case 253:
+			if v, ok := f.Uint64(); ok {
+				msg.Timestamp = TimeFromDateTime(uint32(v))
+			}
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Event = Event(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventType = EventType(v)
+			}
+		case 2:
+			if v, ok := f.Uint64(); ok {
+				msg.StartTime = TimeFromDateTime(uint32(v))
+			}
+		case 3:
+			if v, ok := f.Int64(); ok {
+				msg.StartPositionLat = DegreesFromSemicircles(int32(v))
+			}
+		case 4:
+			if v, ok := f.Int64(); ok {
+				msg.StartPositionLong = DegreesFromSemicircles(int32(v))
+			}
+		case 5:
+			if v, ok := f.Int64(); ok {
+				msg.EndPositionLat = DegreesFromSemicircles(int32(v))
+			}
+		case 6:
+			if v, ok := f.Int64(); ok {
+				msg.EndPositionLong = DegreesFromSemicircles(int32(v))
+			}
+		case 7:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.TotalElapsedTime = v
+			}
+		case 8:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.TotalTimerTime = v
+			}
+		case 9:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.TotalDistance = v
+			}
+		case 10:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalCycles = uint32(v)
+			}
+		case 11:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalCalories = uint16(v)
+			}
+		case 12:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalFatCalories = uint16(v)
+			}
+		case 13:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.AvgSpeed = v
+			}
+		case 14:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.MaxSpeed = v
+			}
+		case 15:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AvgHeartRate = uint8(v)
+			}
+		case 16:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MaxHeartRate = uint8(v)
+			}
+		case 17:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AvgCadence = uint8(v)
+			}
+		case 18:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MaxCadence = uint8(v)
+			}
+		case 19:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AvgPower = uint16(v)
+			}
+		case 20:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MaxPower = uint16(v)
+			}
+		case 21:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalAscent = uint16(v)
+			}
+		case 22:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalDescent = uint16(v)
+			}
+		case 23:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Intensity = Intensity(v)
+			}
+		case 24:
+			if v, ok := f.Value.(uint64); ok {
+				msg.LapTrigger = LapTrigger(v)
+			}
+		case 25:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Sport = Sport(v)
+			}
+		case 26:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventGroup = uint8(v)
+			}
+		case 32:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NumLengths = uint16(v)
+			}
+		case 33:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NormalizedPower = uint16(v)
+			}
+		case 35:
+			if v, ok := f.Value.(uint64); ok {
+				msg.FirstLengthIndex = uint16(v)
+			}
+		case 38:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SwimStroke = SwimStroke(v)
+			}
+		case 39:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SubSport = SubSport(v)
+			}
+		case 40:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NumActiveLengths = uint16(v)
+			}
+		case 41:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalWork = uint32(v)
+			}
+		case 42:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.AvgAltitude = v
+			}
+		case 43:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.MaxAltitude = v
+			}
+		case 62:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.MinAltitude = v
+			}
+		case 110:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.EnhancedAvgSpeed = v
+			}
+		case 111:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.EnhancedMaxSpeed = v
+			}
+		case 112:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.EnhancedAvgAltitude = v
+			}
+		case 113:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.EnhancedMinAltitude = v
+			}
+		case 114:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.EnhancedMaxAltitude = v
+			}
+		}
+	}
+	return nil
+}
+
+// LengthMsg is the length message of the Global FIT Profile.
+type LengthMsg struct {
+	MessageIndex       MessageIndex
+	Timestamp          time.Time
+	Event              Event
+	EventType          EventType
+	StartTime          time.Time
+	TotalElapsedTime   float64    // s
+	TotalTimerTime     float64    // s
+	TotalStrokes       uint16     // strokes
+	AvgSpeed           float64    // m/s
+	SwimStroke         SwimStroke // swim_stroke
+	AvgSwimmingCadence uint8      // strokes/min
+	EventGroup         uint8
+	TotalCalories      uint16 // kcal
+	LengthType         LengthType
+	PlayerScore        uint16
+	OpponentScore      uint16
+	StrokeCount        []uint16 // counts
+	ZoneCount          []uint16 // counts
+}
+
+// NewLengthMsg returns a new LengthMsg with every field set to its invalid value.
+func NewLengthMsg() LengthMsg {
+	return LengthMsg{
+		MessageIndex:       0xFFFF,
+		Event:              0xFF,
+		EventType:          0xFF,
+		TotalElapsedTime:   math.NaN(),
+		TotalTimerTime:     math.NaN(),
+		TotalStrokes:       0xFFFF,
+		AvgSpeed:           math.NaN(),
+		SwimStroke:         0xFF,
+		AvgSwimmingCadence: 0xFF,
+		EventGroup:         0xFF,
+		TotalCalories:      0xFFFF,
+		LengthType:         0xFF,
+		PlayerScore:        0xFFFF,
+		OpponentScore:      0xFFFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded length data
+// message. Fields missing from m are set to their invalid value.
+func (msg *LengthMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Length {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewLengthMsg()
+	if m.Timestamp != nil {
+		msg.Timestamp = TimeFromDateTime(*m.Timestamp)
+	}
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 254:
+			if v, ok := f.Value.(uint64); ok {
+				msg.MessageIndex = MessageIndex(v)
+			}
+		case 253:
+			if v, ok := f.Uint64(); ok {
+				msg.Timestamp = TimeFromDateTime(uint32(v))
+			}
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Event = Event(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventType = EventType(v)
+			}
+		case 2:
+			if v, ok := f.Uint64(); ok {
+				msg.StartTime = TimeFromDateTime(uint32(v))
+			}
+		case 3:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.TotalElapsedTime = v
+			}
+		case 4:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.TotalTimerTime = v
+			}
+		case 5:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalStrokes = uint16(v)
+			}
+		case 6:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.AvgSpeed = v
+			}
+		case 7:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SwimStroke = SwimStroke(v)
+			}
+		case 9:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AvgSwimmingCadence = uint8(v)
+			}
+		case 10:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventGroup = uint8(v)
+			}
+		case 11:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalCalories = uint16(v)
+			}
+		case 12:
+			if v, ok := f.Value.(uint64); ok {
+				msg.LengthType = LengthType(v)
+			}
+		case 18:
+			if v, ok := f.Value.(uint64); ok {
+				msg.PlayerScore = uint16(v)
+			}
+		case 19:
+			if v, ok := f.Value.(uint64); ok {
+				msg.OpponentScore = uint16(v)
+			}
+		case 20:
+			for _, v := range fieldUints(f) {
+				msg.StrokeCount = append(msg.StrokeCount, uint16(v))
+			}
+		case 21:
+			for _, v := range fieldUints(f) {
+				msg.ZoneCount = append(msg.ZoneCount, uint16(v))
+			}
+		}
+	}
+	return nil
+}
+
+// RecordMsg is the record message of the Global FIT Profile.
+type RecordMsg struct {
+	Timestamp                  time.Time
+	PositionLat                float64 // degrees
+	PositionLong               float64 // degrees
+	Altitude                   float64 // m
+	HeartRate                  uint8   // bpm
+	Cadence                    uint8   // rpm
+	Distance                   float64 // m
+	Speed                      float64 // m/s
+	Power                      uint16  // watts
+	CompressedSpeedDistance    []byte
+	Grade                      float64 // %
+	Resistance                 uint8
+	TimeFromCourse             float64   // s
+	CycleLength                float64   // m
+	Temperature                int8      // C
+	Speed1s                    []float64 // m/s
+	Cycles                     uint8     // cycles
+	TotalCycles                uint32    // cycles
+	CompressedAccumulatedPower uint16    // watts
+	AccumulatedPower           uint32    // watts
+	LeftRightBalance           uint8
+	GpsAccuracy                uint8   // m
+	VerticalSpeed              float64 // m/s
+	Calories                   uint16  // kcal
+	VerticalOscillation        float64 // mm
+	StanceTimePercent          float64 // percent
+	StanceTime                 float64 // ms
+	ActivityType               ActivityType
+	FractionalCadence          float64 // rpm
+	EnhancedSpeed              float64 // m/s
+	EnhancedAltitude           float64 // m
+	VerticalRatio              float64 // percent
+	StepLength                 float64 // mm
+}
+
+// NewRecordMsg returns a new RecordMsg with every field set to its invalid value.
+func NewRecordMsg() RecordMsg {
+	return RecordMsg{
+		PositionLat:                math.NaN(),
+		PositionLong:               math.NaN(),
+		Altitude:                   math.NaN(),
+		HeartRate:                  0xFF,
+		Cadence:                    0xFF,
+		Distance:                   math.NaN(),
+		Speed:                      math.NaN(),
+		Power:                      0xFFFF,
+		Grade:                      math.NaN(),
+		Resistance:                 0xFF,
+		TimeFromCourse:             math.NaN(),
+		CycleLength:                math.NaN(),
+		Temperature:                0x7F,
+		Cycles:                     0xFF,
+		TotalCycles:                0xFFFFFFFF,
+		CompressedAccumulatedPower: 0xFFFF,
+		AccumulatedPower:           0xFFFFFFFF,
+		LeftRightBalance:           0xFF,
+		GpsAccuracy:                0xFF,
+		VerticalSpeed:              math.NaN(),
+		Calories:                   0xFFFF,
+		VerticalOscillation:        math.NaN(),
+		StanceTimePercent:          math.NaN(),
+		StanceTime:                 math.NaN(),
+		ActivityType:               0xFF,
+		FractionalCadence:          math.NaN(),
+		EnhancedSpeed:              math.NaN(),
+		EnhancedAltitude:           math.NaN(),
+		VerticalRatio:              math.NaN(),
+		StepLength:                 math.NaN(),
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded record data
+// message. Fields missing from m are set to their invalid value.
+func (msg *RecordMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Record {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewRecordMsg()
+	if m.Timestamp != nil {
+		msg.Timestamp = TimeFromDateTime(*m.Timestamp)
+	}
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 253:
+			if v, ok := f.Uint64(); ok {
+				msg.Timestamp = TimeFromDateTime(uint32(v))
+			}
+		case 0:
+			if v, ok := f.Int64(); ok {
+				msg.PositionLat = DegreesFromSemicircles(int32(v))
+			}
+		case 1:
+			if v, ok := f.Int64(); ok {
+				msg.PositionLong = DegreesFromSemicircles(int32(v))
+			}
+		case 2:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.Altitude = v
+			}
+		case 3:
+			if v, ok := f.Value.(uint64); ok {
+				msg.HeartRate = uint8(v)
+			}
+		case 4:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Cadence = uint8(v)
+			}
+		case 5:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.Distance = v
+			}
+		case 6:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.Speed = v
+			}
+		case 7:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Power = uint16(v)
+			}
+		case 8:
+			if v, ok := f.Value.([]byte); ok && !f.Invalid {
+				msg.CompressedSpeedDistance = v
+			}
+		case 9:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.Grade = v
+			}
+		case 10:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Resistance = uint8(v)
+			}
+		case 11:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.TimeFromCourse = v
+			}
+		case 12:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.CycleLength = v
+			}
+		case 13:
+			if v, ok := f.Value.(int64); ok {
+				msg.Temperature = int8(v)
+			}
+		case 17:
+			msg.Speed1s = fieldScaledFloats(f, 16, 0)
+		case 18:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Cycles = uint8(v)
+			}
+		case 19:
+			if v, ok := f.Value.(uint64); ok {
+				msg.TotalCycles = uint32(v)
+			}
+		case 28:
+			if v, ok := f.Value.(uint64); ok {
+				msg.CompressedAccumulatedPower = uint16(v)
+			}
+		case 29:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AccumulatedPower = uint32(v)
+			}
+		case 30:
+			if v, ok := f.Value.(uint64); ok {
+				msg.LeftRightBalance = uint8(v)
+			}
+		case 31:
+			if v, ok := f.Value.(uint64); ok {
+				msg.GpsAccuracy = uint8(v)
+			}
+		case 32:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.VerticalSpeed = v
+			}
+		case 33:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Calories = uint16(v)
+			}
+		case 39:
+			if v, ok := fieldScaled(f, 10, 0); ok {
+				msg.VerticalOscillation = v
+			}
+		case 40:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.StanceTimePercent = v
+			}
+		case 41:
+			if v, ok := fieldScaled(f, 10, 0); ok {
+				msg.StanceTime = v
+			}
+		case 42:
+			if v, ok := f.Value.(uint64); ok {
+				msg.ActivityType = ActivityType(v)
+			}
+		case 53:
+			if v, ok := fieldScaled(f, 128, 0); ok {
+				msg.FractionalCadence = v
+			}
+		case 73:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.EnhancedSpeed = v
+			}
+		case 78:
+			if v, ok := fieldScaled(f, 5, 500); ok {
+				msg.EnhancedAltitude = v
+			}
+		case 83:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.VerticalRatio = v
+			}
+		case 85:
+			if v, ok := fieldScaled(f, 10, 0); ok {
+				msg.StepLength = v
+			}
+		}
+	}
+	return nil
+}
+
+// EventMsg is the event message of the Global FIT Profile.
+type EventMsg struct {
+	Timestamp     time.Time
+	Event         Event
+	EventType     EventType
+	Data16        uint16
+	Data          uint32
+	EventGroup    uint8
+	Score         uint16
+	OpponentScore uint16
+	FrontGearNum  uint8
+	FrontGear     uint8
+	RearGearNum   uint8
+	RearGear      uint8
+	DeviceIndex   DeviceIndex
+}
+
+// NewEventMsg returns a new EventMsg with every field set to its invalid value.
+func NewEventMsg() EventMsg {
+	return EventMsg{
+		Event:         0xFF,
+		EventType:     0xFF,
+		Data16:        0xFFFF,
+		Data:          0xFFFFFFFF,
+		EventGroup:    0xFF,
+		Score:         0xFFFF,
+		OpponentScore: 0xFFFF,
+		DeviceIndex:   0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded event data
+// message. Fields missing from m are set to their invalid value.
+func (msg *EventMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Event {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewEventMsg()
+	if m.Timestamp != nil {
+		msg.Timestamp = TimeFromDateTime(*m.Timestamp)
+	}
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 253:
+			if v, ok := f.Uint64(); ok {
+				msg.Timestamp = TimeFromDateTime(uint32(v))
+			}
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Event = Event(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventType = EventType(v)
+			}
+		case 2:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Data16 = uint16(v)
+			}
+		case 3:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Data = uint32(v)
+			}
+		case 4:
+			if v, ok := f.Value.(uint64); ok {
+				msg.EventGroup = uint8(v)
+			}
+		case 7:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Score = uint16(v)
+			}
+		case 8:
+			if v, ok := f.Value.(uint64); ok {
+				msg.OpponentScore = uint16(v)
+			}
+		case 9:
+			if v, ok := f.Value.(uint64); ok {
+				msg.FrontGearNum = uint8(v)
+			}
+		case 10:
+			if v, ok := f.Value.(uint64); ok {
+				msg.FrontGear = uint8(v)
+			}
+		case 11:
+			if v, ok := f.Value.(uint64); ok {
+				msg.RearGearNum = uint8(v)
+			}
+		case 12:
+			if v, ok := f.Value.(uint64); ok {
+				msg.RearGear = uint8(v)
+			}
+		case 13:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DeviceIndex = DeviceIndex(v)
+			}
+		}
+	}
+	return nil
+}
+
+// DeviceInfoMsg is the device_info message of the Global FIT Profile.
+type DeviceInfoMsg struct {
+	Timestamp           time.Time
+	DeviceIndex         DeviceIndex
+	DeviceType          uint8
+	Manufacturer        Manufacturer
+	SerialNumber        uint32
+	Product             uint16
+	SoftwareVersion     float64
+	HardwareVersion     uint8
+	CumOperatingTime    uint32  // s
+	BatteryVoltage      float64 // V
+	BatteryStatus       BatteryStatus
+	Descriptor          string
+	AntTransmissionType uint8
+	AntDeviceNumber     uint16
+	AntNetwork          AntNetwork
+	SourceType          SourceType
+	ProductName         string
+	BatteryLevel        uint8 // %
+}
+
+// NewDeviceInfoMsg returns a new DeviceInfoMsg with every field set to its invalid value.
+func NewDeviceInfoMsg() DeviceInfoMsg {
+	return DeviceInfoMsg{
+		DeviceIndex:      0xFF,
+		DeviceType:       0xFF,
+		Manufacturer:     0xFFFF,
+		Product:          0xFFFF,
+		SoftwareVersion:  math.NaN(),
+		HardwareVersion:  0xFF,
+		CumOperatingTime: 0xFFFFFFFF,
+		BatteryVoltage:   math.NaN(),
+		BatteryStatus:    0xFF,
+		AntNetwork:       0xFF,
+		SourceType:       0xFF,
+		BatteryLevel:     0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded device_info data
+// message. Fields missing from m are set to their invalid value.
+func (msg *DeviceInfoMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_DeviceInfo {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewDeviceInfoMsg()
+	if m.Timestamp != nil {
+		msg.Timestamp = TimeFromDateTime(*m.Timestamp)
+	}
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 253:
+			if v, ok := f.Uint64(); ok {
+				msg.Timestamp = TimeFromDateTime(uint32(v))
+			}
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DeviceIndex = DeviceIndex(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DeviceType = uint8(v)
+			}
+		case 2:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Manufacturer = Manufacturer(v)
+			}
+		case 3:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SerialNumber = uint32(v)
+			}
+		case 4:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Product = uint16(v)
+			}
+		case 5:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.SoftwareVersion = v
+			}
+		case 6:
+			if v, ok := f.Value.(uint64); ok {
+				msg.HardwareVersion = uint8(v)
+			}
+		case 7:
+			if v, ok := f.Value.(uint64); ok {
+				msg.CumOperatingTime = uint32(v)
+			}
+		case 10:
+			if v, ok := fieldScaled(f, 256, 0); ok {
+				msg.BatteryVoltage = v
+			}
+		case 11:
+			if v, ok := f.Value.(uint64); ok {
+				msg.BatteryStatus = BatteryStatus(v)
+			}
+		case 19:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.Descriptor = v
+			}
+		case 20:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AntTransmissionType = uint8(v)
+			}
+		case 21:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AntDeviceNumber = uint16(v)
+			}
+		case 22:
+			if v, ok := f.Value.(uint64); ok {
+				msg.AntNetwork = AntNetwork(v)
+			}
+		case 25:
+			if v, ok := f.Value.(uint64); ok {
+				msg.SourceType = SourceType(v)
+			}
+		case 27:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.ProductName = v
+			}
+		case 32:
+			if v, ok := f.Value.(uint64); ok {
+				msg.BatteryLevel = uint8(v)
+			}
+		}
+	}
+	return nil
+}
+
+// MonitoringMsg is the monitoring message of the Global FIT Profile.
+type MonitoringMsg struct {
+	Timestamp                    time.Time
+	DeviceIndex                  DeviceIndex
+	Calories                     uint16  // kcal
+	Distance                     float64 // m
+	Cycles                       float64 // cycles
+	ActiveTime                   float64 // s
+	ActivityType                 ActivityType
+	ActivitySubtype              uint8
+	CurrentActivityTypeIntensity []byte
+	Timestamp16                  uint16 // s
+	HeartRate                    uint8  // bpm
+	Intensity                    float64
+	DurationMin                  uint16 // min
+	Duration                     uint32 // s
+}
+
+// NewMonitoringMsg returns a new MonitoringMsg with every field set to its invalid value.
+func NewMonitoringMsg() MonitoringMsg {
+	return MonitoringMsg{
+		DeviceIndex:     0xFF,
+		Calories:        0xFFFF,
+		Distance:        math.NaN(),
+		Cycles:          math.NaN(),
+		ActiveTime:      math.NaN(),
+		ActivityType:    0xFF,
+		ActivitySubtype: 0xFF,
+		Timestamp16:     0xFFFF,
+		HeartRate:       0xFF,
+		Intensity:       math.NaN(),
+		DurationMin:     0xFFFF,
+		Duration:        0xFFFFFFFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded monitoring data
+// message. Fields missing from m are set to their invalid value.
+func (msg *MonitoringMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Monitoring {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewMonitoringMsg()
+	if m.Timestamp != nil {
+		msg.Timestamp = TimeFromDateTime(*m.Timestamp)
+	}
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 253:
+			if v, ok := f.Uint64(); ok {
+				msg.Timestamp = TimeFromDateTime(uint32(v))
+			}
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DeviceIndex = DeviceIndex(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Calories = uint16(v)
+			}
+		case 2:
+			if v, ok := fieldScaled(f, 100, 0); ok {
+				msg.Distance = v
+			}
+		case 3:
+			if v, ok := fieldScaled(f, 2, 0); ok {
+				msg.Cycles = v
+			}
+		case 4:
+			if v, ok := fieldScaled(f, 1000, 0); ok {
+				msg.ActiveTime = v
+			}
+		case 5:
+			if v, ok := f.Value.(uint64); ok {
+				msg.ActivityType = ActivityType(v)
+			}
+		case 6:
+			if v, ok := f.Value.(uint64); ok {
+				msg.ActivitySubtype = uint8(v)
+			}
+		case 24:
+			if v, ok := f.Value.([]byte); ok && !f.Invalid {
+				msg.CurrentActivityTypeIntensity = v
+			}
+		case 26:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Timestamp16 = uint16(v)
+			}
+		case 27:
+			if v, ok := f.Value.(uint64); ok {
+				msg.HeartRate = uint8(v)
+			}
+		case 28:
+			if v, ok := fieldScaled(f, 10, 0); ok {
+				msg.Intensity = v
+			}
+		case 29:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DurationMin = uint16(v)
+			}
+		case 30:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Duration = uint32(v)
+			}
+		}
+	}
+	return nil
+}
+
+// HrvMsg is the hrv message of the Global FIT Profile.
+type HrvMsg struct {
+	Time []float64 // s
+}
+
+// NewHrvMsg returns a new HrvMsg with every field set to its invalid value.
+func NewHrvMsg() HrvMsg {
+	return HrvMsg{}
+}
+
+// Unmarshal sets the fields of the message from a decoded hrv data
+// message. Fields missing from m are set to their invalid value.
+func (msg *HrvMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_Hrv {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewHrvMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 0:
+			msg.Time = fieldScaledFloats(f, 1000, 0)
+		}
+	}
+	return nil
+}
+
+// DeveloperDataIdMsg is the developer_data_id message of the Global FIT Profile.
+type DeveloperDataIdMsg struct {
+	DeveloperId        []byte
+	ApplicationId      []byte
+	ManufacturerId     Manufacturer
+	DeveloperDataIndex uint8
+	ApplicationVersion uint32
+}
+
+// NewDeveloperDataIdMsg returns a new DeveloperDataIdMsg with every field set to its invalid value.
+func NewDeveloperDataIdMsg() DeveloperDataIdMsg {
+	return DeveloperDataIdMsg{
+		ManufacturerId:     0xFFFF,
+		DeveloperDataIndex: 0xFF,
+		ApplicationVersion: 0xFFFFFFFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded developer_data_id data
+// message. Fields missing from m are set to their invalid value.
+func (msg *DeveloperDataIdMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_DeveloperDataId {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewDeveloperDataIdMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 0:
+			if v, ok := f.Value.([]byte); ok && !f.Invalid {
+				msg.DeveloperId = v
+			}
+		case 1:
+			if v, ok := f.Value.([]byte); ok && !f.Invalid {
+				msg.ApplicationId = v
+			}
+		case 2:
+			if v, ok := f.Value.(uint64); ok {
+				msg.ManufacturerId = Manufacturer(v)
+			}
+		case 3:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DeveloperDataIndex = uint8(v)
+			}
+		case 4:
+			if v, ok := f.Value.(uint64); ok {
+				msg.ApplicationVersion = uint32(v)
+			}
+		}
+	}
+	return nil
+}
+
+// FieldDescriptionMsg is the field_description message of the Global FIT Profile.
+type FieldDescriptionMsg struct {
+	DeveloperDataIndex    uint8
+	FieldDefinitionNumber uint8
+	FitBaseTypeId         uint8
+	FieldName             string
+	Array                 uint8
+	Components            string
+	Scale                 uint8
+	Offset                int8
+	Units                 string
+	Bits                  string
+	Accumulate            string
+	FitBaseUnitId         uint16
+	NativeMesgNum         GlobalMessageType
+	NativeFieldNum        uint8
+}
+
+// NewFieldDescriptionMsg returns a new FieldDescriptionMsg with every field set to its invalid value.
+func NewFieldDescriptionMsg() FieldDescriptionMsg {
+	return FieldDescriptionMsg{
+		DeveloperDataIndex:    0xFF,
+		FieldDefinitionNumber: 0xFF,
+		FitBaseTypeId:         0xFF,
+		Array:                 0xFF,
+		Scale:                 0xFF,
+		Offset:                0x7F,
+		FitBaseUnitId:         0xFFFF,
+		NativeMesgNum:         0xFFFF,
+		NativeFieldNum:        0xFF,
+	}
+}
+
+// Unmarshal sets the fields of the message from a decoded field_description data
+// message. Fields missing from m are set to their invalid value.
+func (msg *FieldDescriptionMsg) Unmarshal(m *DataMessage) error {
+	if m == nil || m.GlobalMessageType != GlobalMessageType_FieldDescription {
+		return ErrorGlobalMessageTypeMismatch
+	}
+
+	*msg = NewFieldDescriptionMsg()
+	for i := range m.Fields {
+		f := &m.Fields[i]
+		switch f.Number {
+		case 0:
+			if v, ok := f.Value.(uint64); ok {
+				msg.DeveloperDataIndex = uint8(v)
+			}
+		case 1:
+			if v, ok := f.Value.(uint64); ok {
+				msg.FieldDefinitionNumber = uint8(v)
+			}
+		case 2:
+			if v, ok := f.Value.(uint64); ok {
+				msg.FitBaseTypeId = uint8(v)
+			}
+		case 3:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.FieldName = v
+			}
+		case 4:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Array = uint8(v)
+			}
+		case 5:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.Components = v
+			}
+		case 6:
+			if v, ok := f.Value.(uint64); ok {
+				msg.Scale = uint8(v)
+			}
+		case 7:
+			if v, ok := f.Value.(int64); ok {
+				msg.Offset = int8(v)
+			}
+		case 8:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.Units = v
+			}
+		case 9:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.Bits = v
+			}
+		case 10:
+			if v, ok := f.Value.(string); ok && !f.Invalid {
+				msg.Accumulate = v
+			}
+		case 13:
+			if v, ok := f.Value.(uint64); ok {
+				msg.FitBaseUnitId = uint16(v)
+			}
+		case 14:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NativeMesgNum = GlobalMessageType(v)
+			}
+		case 15:
+			if v, ok := f.Value.(uint64); ok {
+				msg.NativeFieldNum = uint8(v)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package fit
+
+// CRCMode controls how a CRC mismatch is handled while decoding.
+type CRCMode int
+
+const (
+	// CRCModeStrict aborts decoding with an *ErrCRCMismatch.
+	CRCModeStrict CRCMode = iota
+	// CRCModeWarn records the *ErrCRCMismatch in File.Warnings and keeps
+	// decoding.
+	CRCModeWarn
+)
+
+type decodeOptions struct {
+	crcMode           CRCMode
+	dropInvalidFields bool
+}
+
+// DecodeOption configures how a file is decoded.
+type DecodeOption func(*decodeOptions)
+
+// WithCRCMode sets how CRC mismatches are handled. The default is
+// CRCModeStrict.
+func WithCRCMode(mode CRCMode) DecodeOption {
+	return func(o *decodeOptions) {
+		o.crcMode = mode
+	}
+}
+
+// WithDropInvalidFields removes fields holding their base type's invalid
+// sentinel from decoded data messages instead of reporting them as null.
+func WithDropInvalidFields() DecodeOption {
+	return func(o *decodeOptions) {
+		o.dropInvalidFields = true
+	}
+}
+
+func newDecodeOptions(opts ...DecodeOption) *decodeOptions {
+	o := new(decodeOptions)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+type writerOptions struct {
+	header FileHeader
+}
+
+// WriterOption configures how a Writer encodes a file.
+type WriterOption func(*writerOptions)
+
+// WithHeader sets the size, protocol version, profile version and data type
+// of the header the Writer writes. Its data size, and its CRC when HasCRC is
+// set, are computed on Close. The default is a 14 byte header with a CRC,
+// DefaultProtocolVersion and DefaultProfileVersion.
+func WithHeader(header FileHeader) WriterOption {
+	return func(o *writerOptions) {
+		o.header = header
+	}
+}
+
+func newWriterOptions(opts ...WriterOption) *writerOptions {
+	o := &writerOptions{
+		header: FileHeader{
+			Size:            MaximumHeaderSize,
+			ProtocolVersion: DefaultProtocolVersion,
+			ProfileVersion:  DefaultProfileVersion,
+			DataType:        DataTypeFIT,
+			HasCRC:          true,
+		},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -0,0 +1,72 @@
+package fit
+
+//go:generate go run ./internal/cmd/fitgen -types profile/Types.csv -messages profile/Messages.csv -o profile_generated.go -structs messages_generated.go
+
+// ProfileType is a type of the Global FIT Profile. Enumerations carry their
+// named values; aliases of a base type such as date_time carry only the
+// values the profile gives special meaning to.
+type ProfileType struct {
+	Name     string
+	BaseType uint8
+	// Enum reports whether the type is an enumeration with a generated Go
+	// type, such as Sport, whose values are known by their names.
+	Enum   bool
+	Values map[uint64]string
+}
+
+// ProfileMessage describes a message of the Global FIT Profile.
+type ProfileMessage struct {
+	Name   string
+	Type   GlobalMessageType
+	Fields map[uint8]*ProfileField
+}
+
+// ProfileField describes a field of a profile message.
+type ProfileField struct {
+	Number uint8
+	Name   string
+	// Type is the name of the profile type or base type of the field.
+	Type     string
+	BaseType uint8
+	Array    bool
+	Scale    float64
+	Offset   float64
+	Units    string
+	// Accumulate reports whether the field holds a rolling counter that
+	// must be accumulated across messages.
+	Accumulate bool
+	Components []ProfileComponent
+	Subfields  []ProfileSubfield
+}
+
+// ProfileComponent describes a run of bits of a field that expands into
+// another field of the same message.
+type ProfileComponent struct {
+	// Number is the number of the field the component expands into.
+	Number     uint8
+	Scale      float64
+	Offset     float64
+	Units      string
+	Bits       uint8
+	Accumulate bool
+}
+
+// ProfileSubfield is an alternative interpretation of a field that applies
+// when another field of the same message holds one of the referenced values.
+type ProfileSubfield struct {
+	Name       string
+	Type       string
+	BaseType   uint8
+	Scale      float64
+	Offset     float64
+	Units      string
+	Components []ProfileComponent
+	References []ProfileReference
+}
+
+// ProfileReference selects a subfield when the field with the given number
+// holds the given raw value.
+type ProfileReference struct {
+	Number uint8
+	Value  uint64
+}
@@ -0,0 +1,5937 @@
+// Code generated by fitgen from the Global FIT Profile. DO NOT EDIT.
+
+package fit
+
+const (
+	BaseTypeNumber_Enum    = 0
+	BaseTypeNumber_Sint8   = 1
+	BaseTypeNumber_Uint8   = 2
+	BaseTypeNumber_Sint16  = 3
+	BaseTypeNumber_Uint16  = 4
+	BaseTypeNumber_Sint32  = 5
+	BaseTypeNumber_Uint32  = 6
+	BaseTypeNumber_String  = 7
+	BaseTypeNumber_Float32 = 8
+	BaseTypeNumber_Float64 = 9
+	BaseTypeNumber_Uint8z  = 10
+	BaseTypeNumber_Uint16z = 11
+	BaseTypeNumber_Uint32z = 12
+	BaseTypeNumber_Byte    = 13
+	BaseTypeNumber_Sint64  = 14
+	BaseTypeNumber_Uint64  = 15
+	BaseTypeNumber_Uint64z = 16
+)
+
+const (
+	GlobalMessageType_FileId                      GlobalMessageType = 0
+	GlobalMessageType_Capabilities                GlobalMessageType = 1
+	GlobalMessageType_DeviceSettings              GlobalMessageType = 2
+	GlobalMessageType_UserProfile                 GlobalMessageType = 3
+	GlobalMessageType_HrmProfile                  GlobalMessageType = 4
+	GlobalMessageType_SdmProfile                  GlobalMessageType = 5
+	GlobalMessageType_BikeProfile                 GlobalMessageType = 6
+	GlobalMessageType_ZonesTarget                 GlobalMessageType = 7
+	GlobalMessageType_HrZone                      GlobalMessageType = 8
+	GlobalMessageType_PowerZone                   GlobalMessageType = 9
+	GlobalMessageType_MetZone                     GlobalMessageType = 10
+	GlobalMessageType_Sport                       GlobalMessageType = 12
+	GlobalMessageType_Goal                        GlobalMessageType = 15
+	GlobalMessageType_Session                     GlobalMessageType = 18
+	GlobalMessageType_Lap                         GlobalMessageType = 19
+	GlobalMessageType_Record                      GlobalMessageType = 20
+	GlobalMessageType_Event                       GlobalMessageType = 21
+	GlobalMessageType_DeviceInfo                  GlobalMessageType = 23
+	GlobalMessageType_Workout                     GlobalMessageType = 26
+	GlobalMessageType_WorkoutStep                 GlobalMessageType = 27
+	GlobalMessageType_Schedule                    GlobalMessageType = 28
+	GlobalMessageType_WeightScale                 GlobalMessageType = 30
+	GlobalMessageType_Course                      GlobalMessageType = 31
+	GlobalMessageType_CoursePoint                 GlobalMessageType = 32
+	GlobalMessageType_Totals                      GlobalMessageType = 33
+	GlobalMessageType_Activity                    GlobalMessageType = 34
+	GlobalMessageType_Software                    GlobalMessageType = 35
+	GlobalMessageType_FileCapabilities            GlobalMessageType = 37
+	GlobalMessageType_MesgCapabilities            GlobalMessageType = 38
+	GlobalMessageType_FieldCapabilities           GlobalMessageType = 39
+	GlobalMessageType_FileCreator                 GlobalMessageType = 49
+	GlobalMessageType_BloodPressure               GlobalMessageType = 51
+	GlobalMessageType_SpeedZone                   GlobalMessageType = 53
+	GlobalMessageType_Monitoring                  GlobalMessageType = 55
+	GlobalMessageType_TrainingFile                GlobalMessageType = 72
+	GlobalMessageType_Hrv                         GlobalMessageType = 78
+	GlobalMessageType_AntRx                       GlobalMessageType = 80
+	GlobalMessageType_AntTx                       GlobalMessageType = 81
+	GlobalMessageType_AntChannelId                GlobalMessageType = 82
+	GlobalMessageType_Length                      GlobalMessageType = 101
+	GlobalMessageType_MonitoringInfo              GlobalMessageType = 103
+	GlobalMessageType_Pad                         GlobalMessageType = 105
+	GlobalMessageType_SlaveDevice                 GlobalMessageType = 106
+	GlobalMessageType_Connectivity                GlobalMessageType = 127
+	GlobalMessageType_WeatherConditions           GlobalMessageType = 128
+	GlobalMessageType_WeatherAlert                GlobalMessageType = 129
+	GlobalMessageType_CadenceZone                 GlobalMessageType = 131
+	GlobalMessageType_Hr                          GlobalMessageType = 132
+	GlobalMessageType_SegmentLap                  GlobalMessageType = 142
+	GlobalMessageType_MemoGlob                    GlobalMessageType = 145
+	GlobalMessageType_SegmentId                   GlobalMessageType = 148
+	GlobalMessageType_SegmentLeaderboardEntry     GlobalMessageType = 149
+	GlobalMessageType_SegmentPoint                GlobalMessageType = 150
+	GlobalMessageType_SegmentFile                 GlobalMessageType = 151
+	GlobalMessageType_WorkoutSession              GlobalMessageType = 158
+	GlobalMessageType_WatchfaceSettings           GlobalMessageType = 159
+	GlobalMessageType_GpsMetadata                 GlobalMessageType = 160
+	GlobalMessageType_CameraEvent                 GlobalMessageType = 161
+	GlobalMessageType_TimestampCorrelation        GlobalMessageType = 162
+	GlobalMessageType_GyroscopeData               GlobalMessageType = 164
+	GlobalMessageType_AccelerometerData           GlobalMessageType = 165
+	GlobalMessageType_ThreeDSensorCalibration     GlobalMessageType = 167
+	GlobalMessageType_VideoFrame                  GlobalMessageType = 169
+	GlobalMessageType_ObdiiData                   GlobalMessageType = 174
+	GlobalMessageType_NmeaSentence                GlobalMessageType = 177
+	GlobalMessageType_AviationAttitude            GlobalMessageType = 178
+	GlobalMessageType_Video                       GlobalMessageType = 184
+	GlobalMessageType_VideoTitle                  GlobalMessageType = 185
+	GlobalMessageType_VideoDescription            GlobalMessageType = 186
+	GlobalMessageType_VideoClip                   GlobalMessageType = 187
+	GlobalMessageType_OhrSettings                 GlobalMessageType = 188
+	GlobalMessageType_ExdScreenConfiguration      GlobalMessageType = 200
+	GlobalMessageType_ExdDataFieldConfiguration   GlobalMessageType = 201
+	GlobalMessageType_ExdDataConceptConfiguration GlobalMessageType = 202
+	GlobalMessageType_FieldDescription            GlobalMessageType = 206
+	GlobalMessageType_DeveloperDataId             GlobalMessageType = 207
+	GlobalMessageType_MagnetometerData            GlobalMessageType = 208
+	GlobalMessageType_BarometerData               GlobalMessageType = 209
+	GlobalMessageType_OneDSensorCalibration       GlobalMessageType = 210
+	GlobalMessageType_Set                         GlobalMessageType = 225
+	GlobalMessageType_StressLevel                 GlobalMessageType = 227
+	GlobalMessageType_DiveSettings                GlobalMessageType = 258
+	GlobalMessageType_DiveGas                     GlobalMessageType = 259
+	GlobalMessageType_DiveAlarm                   GlobalMessageType = 262
+	GlobalMessageType_ExerciseTitle               GlobalMessageType = 264
+	GlobalMessageType_DiveSummary                 GlobalMessageType = 268
+	GlobalMessageType_Jump                        GlobalMessageType = 285
+	GlobalMessageType_ClimbPro                    GlobalMessageType = 317
+	GlobalMessageType_MfgRangeMin                 GlobalMessageType = 65280
+	GlobalMessageType_MfgRangeMax                 GlobalMessageType = 65534
+	GlobalMessageType_Unknown                     GlobalMessageType = 0xFFFF
+)
+
+const (
+	// Deprecated: GlobalMessageType_FileID is the name the constant had before it was generated. Use GlobalMessageType_FileId.
+	GlobalMessageType_FileID = GlobalMessageType_FileId
+	// Deprecated: GlobalMessageType_FieldCapabilites is the name the constant had before it was generated. Use GlobalMessageType_FieldCapabilities.
+	GlobalMessageType_FieldCapabilites = GlobalMessageType_FieldCapabilities
+)
+
+var (
+	GlobalMessageNumber_Types = map[uint16]GlobalMessageType{
+		0:     GlobalMessageType_FileId,
+		1:     GlobalMessageType_Capabilities,
+		2:     GlobalMessageType_DeviceSettings,
+		3:     GlobalMessageType_UserProfile,
+		4:     GlobalMessageType_HrmProfile,
+		5:     GlobalMessageType_SdmProfile,
+		6:     GlobalMessageType_BikeProfile,
+		7:     GlobalMessageType_ZonesTarget,
+		8:     GlobalMessageType_HrZone,
+		9:     GlobalMessageType_PowerZone,
+		10:    GlobalMessageType_MetZone,
+		12:    GlobalMessageType_Sport,
+		15:    GlobalMessageType_Goal,
+		18:    GlobalMessageType_Session,
+		19:    GlobalMessageType_Lap,
+		20:    GlobalMessageType_Record,
+		21:    GlobalMessageType_Event,
+		23:    GlobalMessageType_DeviceInfo,
+		26:    GlobalMessageType_Workout,
+		27:    GlobalMessageType_WorkoutStep,
+		28:    GlobalMessageType_Schedule,
+		30:    GlobalMessageType_WeightScale,
+		31:    GlobalMessageType_Course,
+		32:    GlobalMessageType_CoursePoint,
+		33:    GlobalMessageType_Totals,
+		34:    GlobalMessageType_Activity,
+		35:    GlobalMessageType_Software,
+		37:    GlobalMessageType_FileCapabilities,
+		38:    GlobalMessageType_MesgCapabilities,
+		39:    GlobalMessageType_FieldCapabilities,
+		49:    GlobalMessageType_FileCreator,
+		51:    GlobalMessageType_BloodPressure,
+		53:    GlobalMessageType_SpeedZone,
+		55:    GlobalMessageType_Monitoring,
+		72:    GlobalMessageType_TrainingFile,
+		78:    GlobalMessageType_Hrv,
+		80:    GlobalMessageType_AntRx,
+		81:    GlobalMessageType_AntTx,
+		82:    GlobalMessageType_AntChannelId,
+		101:   GlobalMessageType_Length,
+		103:   GlobalMessageType_MonitoringInfo,
+		105:   GlobalMessageType_Pad,
+		106:   GlobalMessageType_SlaveDevice,
+		127:   GlobalMessageType_Connectivity,
+		128:   GlobalMessageType_WeatherConditions,
+		129:   GlobalMessageType_WeatherAlert,
+		131:   GlobalMessageType_CadenceZone,
+		132:   GlobalMessageType_Hr,
+		142:   GlobalMessageType_SegmentLap,
+		145:   GlobalMessageType_MemoGlob,
+		148:   GlobalMessageType_SegmentId,
+		149:   GlobalMessageType_SegmentLeaderboardEntry,
+		150:   GlobalMessageType_SegmentPoint,
+		151:   GlobalMessageType_SegmentFile,
+		158:   GlobalMessageType_WorkoutSession,
+		159:   GlobalMessageType_WatchfaceSettings,
+		160:   GlobalMessageType_GpsMetadata,
+		161:   GlobalMessageType_CameraEvent,
+		162:   GlobalMessageType_TimestampCorrelation,
+		164:   GlobalMessageType_GyroscopeData,
+		165:   GlobalMessageType_AccelerometerData,
+		167:   GlobalMessageType_ThreeDSensorCalibration,
+		169:   GlobalMessageType_VideoFrame,
+		174:   GlobalMessageType_ObdiiData,
+		177:   GlobalMessageType_NmeaSentence,
+		178:   GlobalMessageType_AviationAttitude,
+		184:   GlobalMessageType_Video,
+		185:   GlobalMessageType_VideoTitle,
+		186:   GlobalMessageType_VideoDescription,
+		187:   GlobalMessageType_VideoClip,
+		188:   GlobalMessageType_OhrSettings,
+		200:   GlobalMessageType_ExdScreenConfiguration,
+		201:   GlobalMessageType_ExdDataFieldConfiguration,
+		202:   GlobalMessageType_ExdDataConceptConfiguration,
+		206:   GlobalMessageType_FieldDescription,
+		207:   GlobalMessageType_DeveloperDataId,
+		208:   GlobalMessageType_MagnetometerData,
+		209:   GlobalMessageType_BarometerData,
+		210:   GlobalMessageType_OneDSensorCalibration,
+		225:   GlobalMessageType_Set,
+		227:   GlobalMessageType_StressLevel,
+		258:   GlobalMessageType_DiveSettings,
+		259:   GlobalMessageType_DiveGas,
+		262:   GlobalMessageType_DiveAlarm,
+		264:   GlobalMessageType_ExerciseTitle,
+		268:   GlobalMessageType_DiveSummary,
+		285:   GlobalMessageType_Jump,
+		317:   GlobalMessageType_ClimbPro,
+		65280: GlobalMessageType_MfgRangeMin,
+		65534: GlobalMessageType_MfgRangeMax,
+	}
+
+	GlobalMessageType_Names = map[GlobalMessageType]string{
+		GlobalMessageType_FileId:                      "FILE_ID",
+		GlobalMessageType_Capabilities:                "CAPABILITIES",
+		GlobalMessageType_DeviceSettings:              "DEVICE_SETTINGS",
+		GlobalMessageType_UserProfile:                 "USER_PROFILE",
+		GlobalMessageType_HrmProfile:                  "HRM_PROFILE",
+		GlobalMessageType_SdmProfile:                  "SDM_PROFILE",
+		GlobalMessageType_BikeProfile:                 "BIKE_PROFILE",
+		GlobalMessageType_ZonesTarget:                 "ZONES_TARGET",
+		GlobalMessageType_HrZone:                      "HR_ZONE",
+		GlobalMessageType_PowerZone:                   "POWER_ZONE",
+		GlobalMessageType_MetZone:                     "MET_ZONE",
+		GlobalMessageType_Sport:                       "SPORT",
+		GlobalMessageType_Goal:                        "GOAL",
+		GlobalMessageType_Session:                     "SESSION",
+		GlobalMessageType_Lap:                         "LAP",
+		GlobalMessageType_Record:                      "RECORD",
+		GlobalMessageType_Event:                       "EVENT",
+		GlobalMessageType_DeviceInfo:                  "DEVICE_INFO",
+		GlobalMessageType_Workout:                     "WORKOUT",
+		GlobalMessageType_WorkoutStep:                 "WORKOUT_STEP",
+		GlobalMessageType_Schedule:                    "SCHEDULE",
+		GlobalMessageType_WeightScale:                 "WEIGHT_SCALE",
+		GlobalMessageType_Course:                      "COURSE",
+		GlobalMessageType_CoursePoint:                 "COURSE_POINT",
+		GlobalMessageType_Totals:                      "TOTALS",
+		GlobalMessageType_Activity:                    "ACTIVITY",
+		GlobalMessageType_Software:                    "SOFTWARE",
+		GlobalMessageType_FileCapabilities:            "FILE_CAPABILITIES",
+		GlobalMessageType_MesgCapabilities:            "MESG_CAPABILITIES",
+		GlobalMessageType_FieldCapabilities:           "FIELD_CAPABILITIES",
+		GlobalMessageType_FileCreator:                 "FILE_CREATOR",
+		GlobalMessageType_BloodPressure:               "BLOOD_PRESSURE",
+		GlobalMessageType_SpeedZone:                   "SPEED_ZONE",
+		GlobalMessageType_Monitoring:                  "MONITORING",
+		GlobalMessageType_TrainingFile:                "TRAINING_FILE",
+		GlobalMessageType_Hrv:                         "HRV",
+		GlobalMessageType_AntRx:                       "ANT_RX",
+		GlobalMessageType_AntTx:                       "ANT_TX",
+		GlobalMessageType_AntChannelId:                "ANT_CHANNEL_ID",
+		GlobalMessageType_Length:                      "LENGTH",
+		GlobalMessageType_MonitoringInfo:              "MONITORING_INFO",
+		GlobalMessageType_Pad:                         "PAD",
+		GlobalMessageType_SlaveDevice:                 "SLAVE_DEVICE",
+		GlobalMessageType_Connectivity:                "CONNECTIVITY",
+		GlobalMessageType_WeatherConditions:           "WEATHER_CONDITIONS",
+		GlobalMessageType_WeatherAlert:                "WEATHER_ALERT",
+		GlobalMessageType_CadenceZone:                 "CADENCE_ZONE",
+		GlobalMessageType_Hr:                          "HR",
+		GlobalMessageType_SegmentLap:                  "SEGMENT_LAP",
+		GlobalMessageType_MemoGlob:                    "MEMO_GLOB",
+		GlobalMessageType_SegmentId:                   "SEGMENT_ID",
+		GlobalMessageType_SegmentLeaderboardEntry:     "SEGMENT_LEADERBOARD_ENTRY",
+		GlobalMessageType_SegmentPoint:                "SEGMENT_POINT",
+		GlobalMessageType_SegmentFile:                 "SEGMENT_FILE",
+		GlobalMessageType_WorkoutSession:              "WORKOUT_SESSION",
+		GlobalMessageType_WatchfaceSettings:           "WATCHFACE_SETTINGS",
+		GlobalMessageType_GpsMetadata:                 "GPS_METADATA",
+		GlobalMessageType_CameraEvent:                 "CAMERA_EVENT",
+		GlobalMessageType_TimestampCorrelation:        "TIMESTAMP_CORRELATION",
+		GlobalMessageType_GyroscopeData:               "GYROSCOPE_DATA",
+		GlobalMessageType_AccelerometerData:           "ACCELEROMETER_DATA",
+		GlobalMessageType_ThreeDSensorCalibration:     "THREE_D_SENSOR_CALIBRATION",
+		GlobalMessageType_VideoFrame:                  "VIDEO_FRAME",
+		GlobalMessageType_ObdiiData:                   "OBDII_DATA",
+		GlobalMessageType_NmeaSentence:                "NMEA_SENTENCE",
+		GlobalMessageType_AviationAttitude:            "AVIATION_ATTITUDE",
+		GlobalMessageType_Video:                       "VIDEO",
+		GlobalMessageType_VideoTitle:                  "VIDEO_TITLE",
+		GlobalMessageType_VideoDescription:            "VIDEO_DESCRIPTION",
+		GlobalMessageType_VideoClip:                   "VIDEO_CLIP",
+		GlobalMessageType_OhrSettings:                 "OHR_SETTINGS",
+		GlobalMessageType_ExdScreenConfiguration:      "EXD_SCREEN_CONFIGURATION",
+		GlobalMessageType_ExdDataFieldConfiguration:   "EXD_DATA_FIELD_CONFIGURATION",
+		GlobalMessageType_ExdDataConceptConfiguration: "EXD_DATA_CONCEPT_CONFIGURATION",
+		GlobalMessageType_FieldDescription:            "FIELD_DESCRIPTION",
+		GlobalMessageType_DeveloperDataId:             "DEVELOPER_DATA_ID",
+		GlobalMessageType_MagnetometerData:            "MAGNETOMETER_DATA",
+		GlobalMessageType_BarometerData:               "BAROMETER_DATA",
+		GlobalMessageType_OneDSensorCalibration:       "ONE_D_SENSOR_CALIBRATION",
+		GlobalMessageType_Set:                         "SET",
+		GlobalMessageType_StressLevel:                 "STRESS_LEVEL",
+		GlobalMessageType_DiveSettings:                "DIVE_SETTINGS",
+		GlobalMessageType_DiveGas:                     "DIVE_GAS",
+		GlobalMessageType_DiveAlarm:                   "DIVE_ALARM",
+		GlobalMessageType_ExerciseTitle:               "EXERCISE_TITLE",
+		GlobalMessageType_DiveSummary:                 "DIVE_SUMMARY",
+		GlobalMessageType_Jump:                        "JUMP",
+		GlobalMessageType_ClimbPro:                    "CLIMB_PRO",
+		GlobalMessageType_MfgRangeMin:                 "MFG_RANGE_MIN",
+		GlobalMessageType_MfgRangeMax:                 "MFG_RANGE_MAX",
+		GlobalMessageType_Unknown:                     "UNKNOWN",
+	}
+)
+
+// FileType is the file type of the Global FIT Profile.
+type FileType uint8
+
+const (
+	FileType_Device           FileType = 1
+	FileType_Settings         FileType = 2
+	FileType_Sport            FileType = 3
+	FileType_Activity         FileType = 4
+	FileType_Workout          FileType = 5
+	FileType_Course           FileType = 6
+	FileType_Schedules        FileType = 7
+	FileType_Weight           FileType = 9
+	FileType_Totals           FileType = 10
+	FileType_Goals            FileType = 11
+	FileType_BloodPressure    FileType = 14
+	FileType_MonitoringA      FileType = 15
+	FileType_ActivitySummary  FileType = 20
+	FileType_MonitoringDaily  FileType = 28
+	FileType_MonitoringB      FileType = 32
+	FileType_Segment          FileType = 34
+	FileType_SegmentList      FileType = 35
+	FileType_ExdConfiguration FileType = 40
+	FileType_MfgRangeMin      FileType = 247
+	FileType_MfgRangeMax      FileType = 254
+	FileType_Invalid          FileType = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v FileType) String() string {
+	return enumString("file", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v FileType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("file", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *FileType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("file", data)
+	if err != nil {
+		return err
+	}
+	*v = FileType(value)
+	return nil
+}
+
+// MessageIndex is the message_index type of the Global FIT Profile.
+type MessageIndex uint16
+
+const (
+	MessageIndex_Selected MessageIndex = 32768
+	MessageIndex_Reserved MessageIndex = 28672
+	MessageIndex_Mask     MessageIndex = 4095
+	MessageIndex_Invalid  MessageIndex = 0xFFFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v MessageIndex) String() string {
+	return enumString("message_index", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v MessageIndex) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("message_index", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *MessageIndex) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("message_index", data)
+	if err != nil {
+		return err
+	}
+	*v = MessageIndex(value)
+	return nil
+}
+
+// DeviceIndex is the device_index type of the Global FIT Profile.
+type DeviceIndex uint8
+
+const (
+	DeviceIndex_Creator DeviceIndex = 0
+	DeviceIndex_Invalid DeviceIndex = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v DeviceIndex) String() string {
+	return enumString("device_index", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v DeviceIndex) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("device_index", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *DeviceIndex) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("device_index", data)
+	if err != nil {
+		return err
+	}
+	*v = DeviceIndex(value)
+	return nil
+}
+
+// Gender is the gender type of the Global FIT Profile.
+type Gender uint8
+
+const (
+	Gender_Female  Gender = 0
+	Gender_Male    Gender = 1
+	Gender_Invalid Gender = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v Gender) String() string {
+	return enumString("gender", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v Gender) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("gender", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *Gender) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("gender", data)
+	if err != nil {
+		return err
+	}
+	*v = Gender(value)
+	return nil
+}
+
+// DisplayMeasure is the display_measure type of the Global FIT Profile.
+type DisplayMeasure uint8
+
+const (
+	DisplayMeasure_Metric   DisplayMeasure = 0
+	DisplayMeasure_Statute  DisplayMeasure = 1
+	DisplayMeasure_Nautical DisplayMeasure = 2
+	DisplayMeasure_Invalid  DisplayMeasure = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v DisplayMeasure) String() string {
+	return enumString("display_measure", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v DisplayMeasure) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("display_measure", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *DisplayMeasure) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("display_measure", data)
+	if err != nil {
+		return err
+	}
+	*v = DisplayMeasure(value)
+	return nil
+}
+
+// Sport is the sport type of the Global FIT Profile.
+type Sport uint8
+
+const (
+	Sport_Generic               Sport = 0
+	Sport_Running               Sport = 1
+	Sport_Cycling               Sport = 2
+	Sport_Transition            Sport = 3
+	Sport_FitnessEquipment      Sport = 4
+	Sport_Swimming              Sport = 5
+	Sport_Basketball            Sport = 6
+	Sport_Soccer                Sport = 7
+	Sport_Tennis                Sport = 8
+	Sport_AmericanFootball      Sport = 9
+	Sport_Training              Sport = 10
+	Sport_Walking               Sport = 11
+	Sport_CrossCountrySkiing    Sport = 12
+	Sport_AlpineSkiing          Sport = 13
+	Sport_Snowboarding          Sport = 14
+	Sport_Rowing                Sport = 15
+	Sport_Mountaineering        Sport = 16
+	Sport_Hiking                Sport = 17
+	Sport_Multisport            Sport = 18
+	Sport_Paddling              Sport = 19
+	Sport_Flying                Sport = 20
+	Sport_EBiking               Sport = 21
+	Sport_Motorcycling          Sport = 22
+	Sport_Boating               Sport = 23
+	Sport_Driving               Sport = 24
+	Sport_Golf                  Sport = 25
+	Sport_HangGliding           Sport = 26
+	Sport_HorsebackRiding       Sport = 27
+	Sport_Hunting               Sport = 28
+	Sport_Fishing               Sport = 29
+	Sport_InlineSkating         Sport = 30
+	Sport_RockClimbing          Sport = 31
+	Sport_Sailing               Sport = 32
+	Sport_IceSkating            Sport = 33
+	Sport_SkyDiving             Sport = 34
+	Sport_Snowshoeing           Sport = 35
+	Sport_Snowmobiling          Sport = 36
+	Sport_StandUpPaddleboarding Sport = 37
+	Sport_Surfing               Sport = 38
+	Sport_Wakeboarding          Sport = 39
+	Sport_WaterSkiing           Sport = 40
+	Sport_Kayaking              Sport = 41
+	Sport_Rafting               Sport = 42
+	Sport_Windsurfing           Sport = 43
+	Sport_Kitesurfing           Sport = 44
+	Sport_Tactical              Sport = 45
+	Sport_Jumpmaster            Sport = 46
+	Sport_Boxing                Sport = 47
+	Sport_FloorClimbing         Sport = 48
+	Sport_Diving                Sport = 53
+	Sport_All                   Sport = 254
+	Sport_Invalid               Sport = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v Sport) String() string {
+	return enumString("sport", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v Sport) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("sport", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *Sport) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("sport", data)
+	if err != nil {
+		return err
+	}
+	*v = Sport(value)
+	return nil
+}
+
+// SubSport is the sub_sport type of the Global FIT Profile.
+type SubSport uint8
+
+const (
+	SubSport_Generic              SubSport = 0
+	SubSport_Treadmill            SubSport = 1
+	SubSport_Street               SubSport = 2
+	SubSport_Trail                SubSport = 3
+	SubSport_Track                SubSport = 4
+	SubSport_Spin                 SubSport = 5
+	SubSport_IndoorCycling        SubSport = 6
+	SubSport_Road                 SubSport = 7
+	SubSport_Mountain             SubSport = 8
+	SubSport_Downhill             SubSport = 9
+	SubSport_Recumbent            SubSport = 10
+	SubSport_Cyclocross           SubSport = 11
+	SubSport_HandCycling          SubSport = 12
+	SubSport_TrackCycling         SubSport = 13
+	SubSport_IndoorRowing         SubSport = 14
+	SubSport_Elliptical           SubSport = 15
+	SubSport_StairClimbing        SubSport = 16
+	SubSport_LapSwimming          SubSport = 17
+	SubSport_OpenWater            SubSport = 18
+	SubSport_FlexibilityTraining  SubSport = 19
+	SubSport_StrengthTraining     SubSport = 20
+	SubSport_WarmUp               SubSport = 21
+	SubSport_Match                SubSport = 22
+	SubSport_Exercise             SubSport = 23
+	SubSport_Challenge            SubSport = 24
+	SubSport_IndoorSkiing         SubSport = 25
+	SubSport_CardioTraining       SubSport = 26
+	SubSport_IndoorWalking        SubSport = 27
+	SubSport_EBikeFitness         SubSport = 28
+	SubSport_Bmx                  SubSport = 29
+	SubSport_CasualWalking        SubSport = 30
+	SubSport_SpeedWalking         SubSport = 31
+	SubSport_BikeToRunTransition  SubSport = 32
+	SubSport_RunToBikeTransition  SubSport = 33
+	SubSport_SwimToBikeTransition SubSport = 34
+	SubSport_Atv                  SubSport = 35
+	SubSport_Motocross            SubSport = 36
+	SubSport_Backcountry          SubSport = 37
+	SubSport_Resort               SubSport = 38
+	SubSport_RcDrone              SubSport = 39
+	SubSport_Wingsuit             SubSport = 40
+	SubSport_Whitewater           SubSport = 41
+	SubSport_SkateSkiing          SubSport = 42
+	SubSport_Yoga                 SubSport = 43
+	SubSport_Pilates              SubSport = 44
+	SubSport_IndoorRunning        SubSport = 45
+	SubSport_GravelCycling        SubSport = 46
+	SubSport_EBikeMountain        SubSport = 47
+	SubSport_Commuting            SubSport = 48
+	SubSport_MixedSurface         SubSport = 49
+	SubSport_Navigate             SubSport = 50
+	SubSport_TrackMe              SubSport = 51
+	SubSport_Map                  SubSport = 52
+	SubSport_SingleGasDiving      SubSport = 53
+	SubSport_MultiGasDiving       SubSport = 54
+	SubSport_GaugeDiving          SubSport = 55
+	SubSport_ApneaDiving          SubSport = 56
+	SubSport_ApneaHunting         SubSport = 57
+	SubSport_VirtualActivity      SubSport = 58
+	SubSport_Obstacle             SubSport = 59
+	SubSport_Breathing            SubSport = 62
+	SubSport_SailRace             SubSport = 65
+	SubSport_Ultra                SubSport = 67
+	SubSport_IndoorClimbing       SubSport = 68
+	SubSport_Bouldering           SubSport = 69
+	SubSport_All                  SubSport = 254
+	SubSport_Invalid              SubSport = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v SubSport) String() string {
+	return enumString("sub_sport", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v SubSport) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("sub_sport", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *SubSport) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("sub_sport", data)
+	if err != nil {
+		return err
+	}
+	*v = SubSport(value)
+	return nil
+}
+
+// Activity is the activity type of the Global FIT Profile.
+type Activity uint8
+
+const (
+	Activity_Manual         Activity = 0
+	Activity_AutoMultiSport Activity = 1
+	Activity_Invalid        Activity = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v Activity) String() string {
+	return enumString("activity", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v Activity) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("activity", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *Activity) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("activity", data)
+	if err != nil {
+		return err
+	}
+	*v = Activity(value)
+	return nil
+}
+
+// Intensity is the intensity type of the Global FIT Profile.
+type Intensity uint8
+
+const (
+	Intensity_Active   Intensity = 0
+	Intensity_Rest     Intensity = 1
+	Intensity_Warmup   Intensity = 2
+	Intensity_Cooldown Intensity = 3
+	Intensity_Recovery Intensity = 4
+	Intensity_Interval Intensity = 5
+	Intensity_Other    Intensity = 6
+	Intensity_Invalid  Intensity = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v Intensity) String() string {
+	return enumString("intensity", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v Intensity) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("intensity", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *Intensity) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("intensity", data)
+	if err != nil {
+		return err
+	}
+	*v = Intensity(value)
+	return nil
+}
+
+// SessionTrigger is the session_trigger type of the Global FIT Profile.
+type SessionTrigger uint8
+
+const (
+	SessionTrigger_ActivityEnd      SessionTrigger = 0
+	SessionTrigger_Manual           SessionTrigger = 1
+	SessionTrigger_AutoMultiSport   SessionTrigger = 2
+	SessionTrigger_FitnessEquipment SessionTrigger = 3
+	SessionTrigger_Invalid          SessionTrigger = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v SessionTrigger) String() string {
+	return enumString("session_trigger", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v SessionTrigger) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("session_trigger", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *SessionTrigger) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("session_trigger", data)
+	if err != nil {
+		return err
+	}
+	*v = SessionTrigger(value)
+	return nil
+}
+
+// LapTrigger is the lap_trigger type of the Global FIT Profile.
+type LapTrigger uint8
+
+const (
+	LapTrigger_Manual           LapTrigger = 0
+	LapTrigger_Time             LapTrigger = 1
+	LapTrigger_Distance         LapTrigger = 2
+	LapTrigger_PositionStart    LapTrigger = 3
+	LapTrigger_PositionLap      LapTrigger = 4
+	LapTrigger_PositionWaypoint LapTrigger = 5
+	LapTrigger_PositionMarked   LapTrigger = 6
+	LapTrigger_SessionEnd       LapTrigger = 7
+	LapTrigger_FitnessEquipment LapTrigger = 8
+	LapTrigger_Invalid          LapTrigger = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v LapTrigger) String() string {
+	return enumString("lap_trigger", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v LapTrigger) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("lap_trigger", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *LapTrigger) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("lap_trigger", data)
+	if err != nil {
+		return err
+	}
+	*v = LapTrigger(value)
+	return nil
+}
+
+// TimerTrigger is the timer_trigger type of the Global FIT Profile.
+type TimerTrigger uint8
+
+const (
+	TimerTrigger_Manual           TimerTrigger = 0
+	TimerTrigger_Auto             TimerTrigger = 1
+	TimerTrigger_FitnessEquipment TimerTrigger = 2
+	TimerTrigger_Invalid          TimerTrigger = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v TimerTrigger) String() string {
+	return enumString("timer_trigger", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v TimerTrigger) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("timer_trigger", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *TimerTrigger) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("timer_trigger", data)
+	if err != nil {
+		return err
+	}
+	*v = TimerTrigger(value)
+	return nil
+}
+
+// Event is the event type of the Global FIT Profile.
+type Event uint8
+
+const (
+	Event_Timer                 Event = 0
+	Event_Workout               Event = 3
+	Event_WorkoutStep           Event = 4
+	Event_PowerDown             Event = 5
+	Event_PowerUp               Event = 6
+	Event_OffCourse             Event = 7
+	Event_Session               Event = 8
+	Event_Lap                   Event = 9
+	Event_CoursePoint           Event = 10
+	Event_Battery               Event = 11
+	Event_VirtualPartnerPace    Event = 12
+	Event_HrHighAlert           Event = 13
+	Event_HrLowAlert            Event = 14
+	Event_SpeedHighAlert        Event = 15
+	Event_SpeedLowAlert         Event = 16
+	Event_CadHighAlert          Event = 17
+	Event_CadLowAlert           Event = 18
+	Event_PowerHighAlert        Event = 19
+	Event_PowerLowAlert         Event = 20
+	Event_RecoveryHr            Event = 21
+	Event_BatteryLow            Event = 22
+	Event_TimeDurationAlert     Event = 23
+	Event_DistanceDurationAlert Event = 24
+	Event_CalorieDurationAlert  Event = 25
+	Event_Activity              Event = 26
+	Event_FitnessEquipment      Event = 27
+	Event_Length                Event = 28
+	Event_UserMarker            Event = 32
+	Event_SportPoint            Event = 33
+	Event_Calibration           Event = 36
+	Event_FrontGearChange       Event = 42
+	Event_RearGearChange        Event = 43
+	Event_RiderPositionChange   Event = 44
+	Event_ElevHighAlert         Event = 45
+	Event_ElevLowAlert          Event = 46
+	Event_CommTimeout           Event = 47
+	Event_Invalid               Event = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v Event) String() string {
+	return enumString("event", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v Event) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("event", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *Event) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("event", data)
+	if err != nil {
+		return err
+	}
+	*v = Event(value)
+	return nil
+}
+
+// EventType is the event_type type of the Global FIT Profile.
+type EventType uint8
+
+const (
+	EventType_Start                  EventType = 0
+	EventType_Stop                   EventType = 1
+	EventType_ConsecutiveDepreciated EventType = 2
+	EventType_Marker                 EventType = 3
+	EventType_StopAll                EventType = 4
+	EventType_BeginDepreciated       EventType = 5
+	EventType_EndDepreciated         EventType = 6
+	EventType_EndAllDepreciated      EventType = 7
+	EventType_StopDisable            EventType = 8
+	EventType_StopDisableAll         EventType = 9
+	EventType_Invalid                EventType = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v EventType) String() string {
+	return enumString("event_type", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v EventType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("event_type", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *EventType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("event_type", data)
+	if err != nil {
+		return err
+	}
+	*v = EventType(value)
+	return nil
+}
+
+// RiderPositionType is the rider_position_type type of the Global FIT Profile.
+type RiderPositionType uint8
+
+const (
+	RiderPositionType_Seated               RiderPositionType = 0
+	RiderPositionType_Standing             RiderPositionType = 1
+	RiderPositionType_TransitionToSeated   RiderPositionType = 2
+	RiderPositionType_TransitionToStanding RiderPositionType = 3
+	RiderPositionType_Invalid              RiderPositionType = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v RiderPositionType) String() string {
+	return enumString("rider_position_type", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v RiderPositionType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("rider_position_type", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *RiderPositionType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("rider_position_type", data)
+	if err != nil {
+		return err
+	}
+	*v = RiderPositionType(value)
+	return nil
+}
+
+// CommTimeoutType is the comm_timeout_type type of the Global FIT Profile.
+type CommTimeoutType uint16
+
+const (
+	CommTimeoutType_WildcardPairingTimeout CommTimeoutType = 0
+	CommTimeoutType_PairingTimeout         CommTimeoutType = 1
+	CommTimeoutType_ConnectionLost         CommTimeoutType = 2
+	CommTimeoutType_ConnectionTimeout      CommTimeoutType = 3
+	CommTimeoutType_Invalid                CommTimeoutType = 0xFFFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v CommTimeoutType) String() string {
+	return enumString("comm_timeout_type", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v CommTimeoutType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("comm_timeout_type", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *CommTimeoutType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("comm_timeout_type", data)
+	if err != nil {
+		return err
+	}
+	*v = CommTimeoutType(value)
+	return nil
+}
+
+// BatteryStatus is the battery_status type of the Global FIT Profile.
+type BatteryStatus uint8
+
+const (
+	BatteryStatus_New      BatteryStatus = 1
+	BatteryStatus_Good     BatteryStatus = 2
+	BatteryStatus_Ok       BatteryStatus = 3
+	BatteryStatus_Low      BatteryStatus = 4
+	BatteryStatus_Critical BatteryStatus = 5
+	BatteryStatus_Charging BatteryStatus = 6
+	BatteryStatus_Unknown  BatteryStatus = 7
+	BatteryStatus_Invalid  BatteryStatus = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v BatteryStatus) String() string {
+	return enumString("battery_status", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v BatteryStatus) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("battery_status", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *BatteryStatus) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("battery_status", data)
+	if err != nil {
+		return err
+	}
+	*v = BatteryStatus(value)
+	return nil
+}
+
+// HrType is the hr_type type of the Global FIT Profile.
+type HrType uint8
+
+const (
+	HrType_Normal    HrType = 0
+	HrType_Irregular HrType = 1
+	HrType_Invalid   HrType = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v HrType) String() string {
+	return enumString("hr_type", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v HrType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("hr_type", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *HrType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("hr_type", data)
+	if err != nil {
+		return err
+	}
+	*v = HrType(value)
+	return nil
+}
+
+// SourceType is the source_type type of the Global FIT Profile.
+type SourceType uint8
+
+const (
+	SourceType_Ant                SourceType = 0
+	SourceType_Antplus            SourceType = 1
+	SourceType_Bluetooth          SourceType = 2
+	SourceType_BluetoothLowEnergy SourceType = 3
+	SourceType_Wifi               SourceType = 4
+	SourceType_Local              SourceType = 5
+	SourceType_Invalid            SourceType = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v SourceType) String() string {
+	return enumString("source_type", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v SourceType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("source_type", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *SourceType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("source_type", data)
+	if err != nil {
+		return err
+	}
+	*v = SourceType(value)
+	return nil
+}
+
+// AntplusDeviceType is the antplus_device_type type of the Global FIT Profile.
+type AntplusDeviceType uint8
+
+const (
+	AntplusDeviceType_Antfs                   AntplusDeviceType = 1
+	AntplusDeviceType_BikePower               AntplusDeviceType = 11
+	AntplusDeviceType_EnvironmentSensorLegacy AntplusDeviceType = 12
+	AntplusDeviceType_MultiSportSpeedDistance AntplusDeviceType = 15
+	AntplusDeviceType_Control                 AntplusDeviceType = 16
+	AntplusDeviceType_FitnessEquipment        AntplusDeviceType = 17
+	AntplusDeviceType_BloodPressure           AntplusDeviceType = 18
+	AntplusDeviceType_GeocacheNode            AntplusDeviceType = 19
+	AntplusDeviceType_LightElectricVehicle    AntplusDeviceType = 20
+	AntplusDeviceType_EnvSensor               AntplusDeviceType = 25
+	AntplusDeviceType_Racquet                 AntplusDeviceType = 26
+	AntplusDeviceType_ControlHub              AntplusDeviceType = 27
+	AntplusDeviceType_MuscleOxygen            AntplusDeviceType = 31
+	AntplusDeviceType_Shifting                AntplusDeviceType = 34
+	AntplusDeviceType_BikeLightMain           AntplusDeviceType = 35
+	AntplusDeviceType_BikeLightShared         AntplusDeviceType = 36
+	AntplusDeviceType_Exd                     AntplusDeviceType = 38
+	AntplusDeviceType_BikeRadar               AntplusDeviceType = 40
+	AntplusDeviceType_BikeAero                AntplusDeviceType = 46
+	AntplusDeviceType_WeightScale             AntplusDeviceType = 119
+	AntplusDeviceType_HeartRate               AntplusDeviceType = 120
+	AntplusDeviceType_BikeSpeedCadence        AntplusDeviceType = 121
+	AntplusDeviceType_BikeCadence             AntplusDeviceType = 122
+	AntplusDeviceType_BikeSpeed               AntplusDeviceType = 123
+	AntplusDeviceType_StrideSpeedDistance     AntplusDeviceType = 124
+	AntplusDeviceType_Invalid                 AntplusDeviceType = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v AntplusDeviceType) String() string {
+	return enumString("antplus_device_type", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v AntplusDeviceType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("antplus_device_type", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *AntplusDeviceType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("antplus_device_type", data)
+	if err != nil {
+		return err
+	}
+	*v = AntplusDeviceType(value)
+	return nil
+}
+
+// AntNetwork is the ant_network type of the Global FIT Profile.
+type AntNetwork uint8
+
+const (
+	AntNetwork_Public  AntNetwork = 0
+	AntNetwork_Antplus AntNetwork = 1
+	AntNetwork_Antfs   AntNetwork = 2
+	AntNetwork_Private AntNetwork = 3
+	AntNetwork_Invalid AntNetwork = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v AntNetwork) String() string {
+	return enumString("ant_network", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v AntNetwork) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("ant_network", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *AntNetwork) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("ant_network", data)
+	if err != nil {
+		return err
+	}
+	*v = AntNetwork(value)
+	return nil
+}
+
+// SwimStroke is the swim_stroke type of the Global FIT Profile.
+type SwimStroke uint8
+
+const (
+	SwimStroke_Freestyle    SwimStroke = 0
+	SwimStroke_Backstroke   SwimStroke = 1
+	SwimStroke_Breaststroke SwimStroke = 2
+	SwimStroke_Butterfly    SwimStroke = 3
+	SwimStroke_Drill        SwimStroke = 4
+	SwimStroke_Mixed        SwimStroke = 5
+	SwimStroke_Im           SwimStroke = 6
+	SwimStroke_Invalid      SwimStroke = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v SwimStroke) String() string {
+	return enumString("swim_stroke", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v SwimStroke) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("swim_stroke", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *SwimStroke) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("swim_stroke", data)
+	if err != nil {
+		return err
+	}
+	*v = SwimStroke(value)
+	return nil
+}
+
+// LengthType is the length_type type of the Global FIT Profile.
+type LengthType uint8
+
+const (
+	LengthType_Idle    LengthType = 0
+	LengthType_Active  LengthType = 1
+	LengthType_Invalid LengthType = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v LengthType) String() string {
+	return enumString("length_type", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v LengthType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("length_type", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *LengthType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("length_type", data)
+	if err != nil {
+		return err
+	}
+	*v = LengthType(value)
+	return nil
+}
+
+// ActivityType is the activity_type type of the Global FIT Profile.
+type ActivityType uint8
+
+const (
+	ActivityType_Generic          ActivityType = 0
+	ActivityType_Running          ActivityType = 1
+	ActivityType_Cycling          ActivityType = 2
+	ActivityType_Transition       ActivityType = 3
+	ActivityType_FitnessEquipment ActivityType = 4
+	ActivityType_Swimming         ActivityType = 5
+	ActivityType_Walking          ActivityType = 6
+	ActivityType_Sedentary        ActivityType = 8
+	ActivityType_All              ActivityType = 254
+	ActivityType_Invalid          ActivityType = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v ActivityType) String() string {
+	return enumString("activity_type", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v ActivityType) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("activity_type", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *ActivityType) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("activity_type", data)
+	if err != nil {
+		return err
+	}
+	*v = ActivityType(value)
+	return nil
+}
+
+// WktStepDuration is the wkt_step_duration type of the Global FIT Profile.
+type WktStepDuration uint8
+
+const (
+	WktStepDuration_Time                               WktStepDuration = 0
+	WktStepDuration_Distance                           WktStepDuration = 1
+	WktStepDuration_HrLessThan                         WktStepDuration = 2
+	WktStepDuration_HrGreaterThan                      WktStepDuration = 3
+	WktStepDuration_Calories                           WktStepDuration = 4
+	WktStepDuration_Open                               WktStepDuration = 5
+	WktStepDuration_RepeatUntilStepsCmplt              WktStepDuration = 6
+	WktStepDuration_RepeatUntilTime                    WktStepDuration = 7
+	WktStepDuration_RepeatUntilDistance                WktStepDuration = 8
+	WktStepDuration_RepeatUntilCalories                WktStepDuration = 9
+	WktStepDuration_RepeatUntilHrLessThan              WktStepDuration = 10
+	WktStepDuration_RepeatUntilHrGreaterThan           WktStepDuration = 11
+	WktStepDuration_RepeatUntilPowerLessThan           WktStepDuration = 12
+	WktStepDuration_RepeatUntilPowerGreaterThan        WktStepDuration = 13
+	WktStepDuration_PowerLessThan                      WktStepDuration = 14
+	WktStepDuration_PowerGreaterThan                   WktStepDuration = 15
+	WktStepDuration_TrainingPeaksTss                   WktStepDuration = 16
+	WktStepDuration_RepeatUntilPowerLastLapLessThan    WktStepDuration = 17
+	WktStepDuration_RepeatUntilMaxPowerLastLapLessThan WktStepDuration = 18
+	WktStepDuration_Power3sLessThan                    WktStepDuration = 19
+	WktStepDuration_Power10sLessThan                   WktStepDuration = 20
+	WktStepDuration_Power30sLessThan                   WktStepDuration = 21
+	WktStepDuration_Power3sGreaterThan                 WktStepDuration = 22
+	WktStepDuration_Power10sGreaterThan                WktStepDuration = 23
+	WktStepDuration_Power30sGreaterThan                WktStepDuration = 24
+	WktStepDuration_PowerLapLessThan                   WktStepDuration = 25
+	WktStepDuration_PowerLapGreaterThan                WktStepDuration = 26
+	WktStepDuration_RepeatUntilTrainingPeaksTss        WktStepDuration = 27
+	WktStepDuration_RepetitionTime                     WktStepDuration = 28
+	WktStepDuration_Reps                               WktStepDuration = 29
+	WktStepDuration_TimeOnly                           WktStepDuration = 31
+	WktStepDuration_Invalid                            WktStepDuration = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v WktStepDuration) String() string {
+	return enumString("wkt_step_duration", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v WktStepDuration) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("wkt_step_duration", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *WktStepDuration) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("wkt_step_duration", data)
+	if err != nil {
+		return err
+	}
+	*v = WktStepDuration(value)
+	return nil
+}
+
+// WktStepTarget is the wkt_step_target type of the Global FIT Profile.
+type WktStepTarget uint8
+
+const (
+	WktStepTarget_Speed        WktStepTarget = 0
+	WktStepTarget_HeartRate    WktStepTarget = 1
+	WktStepTarget_Open         WktStepTarget = 2
+	WktStepTarget_Cadence      WktStepTarget = 3
+	WktStepTarget_Power        WktStepTarget = 4
+	WktStepTarget_Grade        WktStepTarget = 5
+	WktStepTarget_Resistance   WktStepTarget = 6
+	WktStepTarget_Power3s      WktStepTarget = 7
+	WktStepTarget_Power10s     WktStepTarget = 8
+	WktStepTarget_Power30s     WktStepTarget = 9
+	WktStepTarget_PowerLap     WktStepTarget = 10
+	WktStepTarget_SwimStroke   WktStepTarget = 11
+	WktStepTarget_SpeedLap     WktStepTarget = 12
+	WktStepTarget_HeartRateLap WktStepTarget = 13
+	WktStepTarget_Invalid      WktStepTarget = 0xFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v WktStepTarget) String() string {
+	return enumString("wkt_step_target", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v WktStepTarget) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("wkt_step_target", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *WktStepTarget) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("wkt_step_target", data)
+	if err != nil {
+		return err
+	}
+	*v = WktStepTarget(value)
+	return nil
+}
+
+// WorkoutHr is the workout_hr type of the Global FIT Profile.
+type WorkoutHr uint32
+
+const (
+	WorkoutHr_BpmOffset WorkoutHr = 100
+	WorkoutHr_Invalid   WorkoutHr = 0xFFFFFFFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v WorkoutHr) String() string {
+	return enumString("workout_hr", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v WorkoutHr) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("workout_hr", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *WorkoutHr) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("workout_hr", data)
+	if err != nil {
+		return err
+	}
+	*v = WorkoutHr(value)
+	return nil
+}
+
+// WorkoutPower is the workout_power type of the Global FIT Profile.
+type WorkoutPower uint32
+
+const (
+	WorkoutPower_WattsOffset WorkoutPower = 1000
+	WorkoutPower_Invalid     WorkoutPower = 0xFFFFFFFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v WorkoutPower) String() string {
+	return enumString("workout_power", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v WorkoutPower) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("workout_power", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *WorkoutPower) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("workout_power", data)
+	if err != nil {
+		return err
+	}
+	*v = WorkoutPower(value)
+	return nil
+}
+
+// Manufacturer is the manufacturer type of the Global FIT Profile.
+type Manufacturer uint16
+
+const (
+	Manufacturer_Garmin                 Manufacturer = 1
+	Manufacturer_Zephyr                 Manufacturer = 3
+	Manufacturer_Dayton                 Manufacturer = 4
+	Manufacturer_Idt                    Manufacturer = 5
+	Manufacturer_Srm                    Manufacturer = 6
+	Manufacturer_Quarq                  Manufacturer = 7
+	Manufacturer_Ibike                  Manufacturer = 8
+	Manufacturer_Saris                  Manufacturer = 9
+	Manufacturer_SparkHk                Manufacturer = 10
+	Manufacturer_Tanita                 Manufacturer = 11
+	Manufacturer_Echowell               Manufacturer = 12
+	Manufacturer_DynastreamOem          Manufacturer = 13
+	Manufacturer_Nautilus               Manufacturer = 14
+	Manufacturer_Dynastream             Manufacturer = 15
+	Manufacturer_Timex                  Manufacturer = 16
+	Manufacturer_Metrigear              Manufacturer = 17
+	Manufacturer_Xelic                  Manufacturer = 18
+	Manufacturer_Beurer                 Manufacturer = 19
+	Manufacturer_Cardiosport            Manufacturer = 20
+	Manufacturer_AAndD                  Manufacturer = 21
+	Manufacturer_Hmm                    Manufacturer = 22
+	Manufacturer_Suunto                 Manufacturer = 23
+	Manufacturer_ThitaElektronik        Manufacturer = 24
+	Manufacturer_Gpulse                 Manufacturer = 25
+	Manufacturer_CleanMobile            Manufacturer = 26
+	Manufacturer_PedalBrain             Manufacturer = 27
+	Manufacturer_Peaksware              Manufacturer = 28
+	Manufacturer_Saxonar                Manufacturer = 29
+	Manufacturer_LemondFitness          Manufacturer = 30
+	Manufacturer_Dexcom                 Manufacturer = 31
+	Manufacturer_WahooFitness           Manufacturer = 32
+	Manufacturer_OctaneFitness          Manufacturer = 33
+	Manufacturer_Archinoetics           Manufacturer = 34
+	Manufacturer_TheHurtBox             Manufacturer = 35
+	Manufacturer_CitizenSystems         Manufacturer = 36
+	Manufacturer_Magellan               Manufacturer = 37
+	Manufacturer_Osynce                 Manufacturer = 38
+	Manufacturer_Holux                  Manufacturer = 39
+	Manufacturer_Concept2               Manufacturer = 40
+	Manufacturer_Shimano                Manufacturer = 41
+	Manufacturer_OneGiantLeap           Manufacturer = 42
+	Manufacturer_AceSensor              Manufacturer = 43
+	Manufacturer_BrimBrothers           Manufacturer = 44
+	Manufacturer_Xplova                 Manufacturer = 45
+	Manufacturer_PerceptionDigital      Manufacturer = 46
+	Manufacturer_Bf1systems             Manufacturer = 47
+	Manufacturer_Pioneer                Manufacturer = 48
+	Manufacturer_Spantec                Manufacturer = 49
+	Manufacturer_Metalogics             Manufacturer = 50
+	Manufacturer_4iiiis                 Manufacturer = 51
+	Manufacturer_SeikoEpson             Manufacturer = 52
+	Manufacturer_SeikoEpsonOem          Manufacturer = 53
+	Manufacturer_IforPowell             Manufacturer = 54
+	Manufacturer_MaxwellGuider          Manufacturer = 55
+	Manufacturer_StarTrac               Manufacturer = 56
+	Manufacturer_Breakaway              Manufacturer = 57
+	Manufacturer_AlatechTechnologyLtd   Manufacturer = 58
+	Manufacturer_MioTechnologyEurope    Manufacturer = 59
+	Manufacturer_Rotor                  Manufacturer = 60
+	Manufacturer_Geonaute               Manufacturer = 61
+	Manufacturer_IdBike                 Manufacturer = 62
+	Manufacturer_Specialized            Manufacturer = 63
+	Manufacturer_Wtek                   Manufacturer = 64
+	Manufacturer_PhysicalEnterprises    Manufacturer = 65
+	Manufacturer_NorthPoleEngineering   Manufacturer = 66
+	Manufacturer_Bkool                  Manufacturer = 67
+	Manufacturer_Cateye                 Manufacturer = 68
+	Manufacturer_StagesCycling          Manufacturer = 69
+	Manufacturer_Sigmasport             Manufacturer = 70
+	Manufacturer_Tomtom                 Manufacturer = 71
+	Manufacturer_Peripedal              Manufacturer = 72
+	Manufacturer_Wattbike               Manufacturer = 73
+	Manufacturer_Moxy                   Manufacturer = 76
+	Manufacturer_Ciclosport             Manufacturer = 77
+	Manufacturer_Powerbahn              Manufacturer = 78
+	Manufacturer_AcornProjectsAps       Manufacturer = 79
+	Manufacturer_Lifebeam               Manufacturer = 80
+	Manufacturer_Bontrager              Manufacturer = 81
+	Manufacturer_Wellgo                 Manufacturer = 82
+	Manufacturer_Scosche                Manufacturer = 83
+	Manufacturer_Magura                 Manufacturer = 84
+	Manufacturer_Woodway                Manufacturer = 85
+	Manufacturer_Elite                  Manufacturer = 86
+	Manufacturer_NielsenKellerman       Manufacturer = 87
+	Manufacturer_DkCity                 Manufacturer = 88
+	Manufacturer_Tacx                   Manufacturer = 89
+	Manufacturer_DirectionTechnology    Manufacturer = 90
+	Manufacturer_Magtonic               Manufacturer = 91
+	Manufacturer_1partcarbon            Manufacturer = 92
+	Manufacturer_InsideRideTechnologies Manufacturer = 93
+	Manufacturer_SoundOfMotion          Manufacturer = 94
+	Manufacturer_Stryd                  Manufacturer = 95
+	Manufacturer_Icg                    Manufacturer = 96
+	Manufacturer_Mipulse                Manufacturer = 97
+	Manufacturer_BsxAthletics           Manufacturer = 98
+	Manufacturer_Look                   Manufacturer = 99
+	Manufacturer_CampagnoloSrl          Manufacturer = 100
+	Manufacturer_BodyBikeSmart          Manufacturer = 101
+	Manufacturer_Praxisworks            Manufacturer = 102
+	Manufacturer_LimitsTechnology       Manufacturer = 103
+	Manufacturer_TopactionTechnology    Manufacturer = 104
+	Manufacturer_Cosinuss               Manufacturer = 105
+	Manufacturer_Fitcare                Manufacturer = 106
+	Manufacturer_Magene                 Manufacturer = 107
+	Manufacturer_GiantManufacturingCo   Manufacturer = 108
+	Manufacturer_Tigrasport             Manufacturer = 109
+	Manufacturer_Salutron               Manufacturer = 110
+	Manufacturer_Technogym              Manufacturer = 111
+	Manufacturer_BrytonSensors          Manufacturer = 112
+	Manufacturer_LatitudeLimited        Manufacturer = 113
+	Manufacturer_SoaringTechnology      Manufacturer = 114
+	Manufacturer_Igpsport               Manufacturer = 115
+	Manufacturer_Thinkrider             Manufacturer = 116
+	Manufacturer_GopherSport            Manufacturer = 117
+	Manufacturer_Waterrower             Manufacturer = 118
+	Manufacturer_Orangetheory           Manufacturer = 119
+	Manufacturer_Inpeak                 Manufacturer = 120
+	Manufacturer_Kinetic                Manufacturer = 121
+	Manufacturer_JohnsonHealthTech      Manufacturer = 122
+	Manufacturer_PolarElectro           Manufacturer = 123
+	Manufacturer_Seesense               Manufacturer = 124
+	Manufacturer_NciTechnology          Manufacturer = 125
+	Manufacturer_Development            Manufacturer = 255
+	Manufacturer_Healthandlife          Manufacturer = 257
+	Manufacturer_Lezyne                 Manufacturer = 258
+	Manufacturer_ScribeLabs             Manufacturer = 259
+	Manufacturer_Zwift                  Manufacturer = 260
+	Manufacturer_Watteam                Manufacturer = 261
+	Manufacturer_Recon                  Manufacturer = 262
+	Manufacturer_FaveroElectronics      Manufacturer = 263
+	Manufacturer_Dynovelo               Manufacturer = 264
+	Manufacturer_Strava                 Manufacturer = 265
+	Manufacturer_Precor                 Manufacturer = 266
+	Manufacturer_Bryton                 Manufacturer = 267
+	Manufacturer_Sram                   Manufacturer = 268
+	Manufacturer_Navman                 Manufacturer = 269
+	Manufacturer_Cobi                   Manufacturer = 270
+	Manufacturer_Spivi                  Manufacturer = 271
+	Manufacturer_MioMagellan            Manufacturer = 272
+	Manufacturer_Evesports              Manufacturer = 273
+	Manufacturer_SensitivusGauge        Manufacturer = 274
+	Manufacturer_Podoon                 Manufacturer = 275
+	Manufacturer_LifeTimeFitness        Manufacturer = 276
+	Manufacturer_FalcoEMotors           Manufacturer = 277
+	Manufacturer_Minoura                Manufacturer = 278
+	Manufacturer_Cycliq                 Manufacturer = 279
+	Manufacturer_Luxottica              Manufacturer = 280
+	Manufacturer_TrainerRoad            Manufacturer = 281
+	Manufacturer_TheSufferfest          Manufacturer = 282
+	Manufacturer_Fullspeedahead         Manufacturer = 283
+	Manufacturer_Virtualtraining        Manufacturer = 284
+	Manufacturer_Feedbacksports         Manufacturer = 285
+	Manufacturer_Omata                  Manufacturer = 286
+	Manufacturer_Vdo                    Manufacturer = 287
+	Manufacturer_Magneticdays           Manufacturer = 288
+	Manufacturer_Hammerhead             Manufacturer = 289
+	Manufacturer_KineticByKurt          Manufacturer = 290
+	Manufacturer_Shapelog               Manufacturer = 291
+	Manufacturer_Dabuziduo              Manufacturer = 292
+	Manufacturer_Jetblack               Manufacturer = 293
+	Manufacturer_Coros                  Manufacturer = 294
+	Manufacturer_Virtugo                Manufacturer = 295
+	Manufacturer_Velosense              Manufacturer = 296
+	Manufacturer_Cycligentinc           Manufacturer = 297
+	Manufacturer_Trailforks             Manufacturer = 298
+	Manufacturer_MahleEbikemotion       Manufacturer = 299
+	Manufacturer_Nurvv                  Manufacturer = 300
+	Manufacturer_Microprogram           Manufacturer = 301
+	Manufacturer_Zone5cloud             Manufacturer = 302
+	Manufacturer_Greenteg               Manufacturer = 303
+	Manufacturer_YamahaMotors           Manufacturer = 304
+	Manufacturer_Whoop                  Manufacturer = 305
+	Manufacturer_Gravaa                 Manufacturer = 306
+	Manufacturer_Onelap                 Manufacturer = 307
+	Manufacturer_MonarkExercise         Manufacturer = 308
+	Manufacturer_Form                   Manufacturer = 309
+	Manufacturer_Decathlon              Manufacturer = 310
+	Manufacturer_Syncros                Manufacturer = 311
+	Manufacturer_Actigraphcorp          Manufacturer = 5759
+	Manufacturer_Invalid                Manufacturer = 0xFFFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v Manufacturer) String() string {
+	return enumString("manufacturer", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v Manufacturer) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("manufacturer", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *Manufacturer) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("manufacturer", data)
+	if err != nil {
+		return err
+	}
+	*v = Manufacturer(value)
+	return nil
+}
+
+// GarminProduct is the garmin_product type of the Global FIT Profile.
+type GarminProduct uint16
+
+const (
+	GarminProduct_Hrm1                       GarminProduct = 1
+	GarminProduct_Axh01                      GarminProduct = 2
+	GarminProduct_Axb01                      GarminProduct = 3
+	GarminProduct_Axb02                      GarminProduct = 4
+	GarminProduct_Hrm2ss                     GarminProduct = 5
+	GarminProduct_DsiAlf02                   GarminProduct = 6
+	GarminProduct_Hrm3ss                     GarminProduct = 7
+	GarminProduct_HrmRunSingleByteProductId  GarminProduct = 8
+	GarminProduct_Bsm                        GarminProduct = 9
+	GarminProduct_Bcm                        GarminProduct = 10
+	GarminProduct_Axs01                      GarminProduct = 11
+	GarminProduct_HrmTriSingleByteProductId  GarminProduct = 12
+	GarminProduct_Hrm4RunSingleByteProductId GarminProduct = 13
+	GarminProduct_Fr225SingleByteProductId   GarminProduct = 14
+	GarminProduct_Gen3BsmSingleByteProductId GarminProduct = 15
+	GarminProduct_Gen3BcmSingleByteProductId GarminProduct = 16
+	GarminProduct_Fr301China                 GarminProduct = 473
+	GarminProduct_Fr301Japan                 GarminProduct = 474
+	GarminProduct_Fr301Korea                 GarminProduct = 475
+	GarminProduct_Fr301Taiwan                GarminProduct = 494
+	GarminProduct_Fr405                      GarminProduct = 717
+	GarminProduct_Fr50                       GarminProduct = 782
+	GarminProduct_Fr405Japan                 GarminProduct = 987
+	GarminProduct_Fr60                       GarminProduct = 988
+	GarminProduct_DsiAlf01                   GarminProduct = 1011
+	GarminProduct_Fr310xt                    GarminProduct = 1018
+	GarminProduct_Edge500                    GarminProduct = 1036
+	GarminProduct_Fr110                      GarminProduct = 1124
+	GarminProduct_Edge800                    GarminProduct = 1169
+	GarminProduct_Edge500Taiwan              GarminProduct = 1199
+	GarminProduct_Edge500Japan               GarminProduct = 1213
+	GarminProduct_Chirp                      GarminProduct = 1253
+	GarminProduct_Fr110Japan                 GarminProduct = 1274
+	GarminProduct_Edge200                    GarminProduct = 1325
+	GarminProduct_Fr910xt                    GarminProduct = 1328
+	GarminProduct_Edge800Taiwan              GarminProduct = 1333
+	GarminProduct_Edge800Japan               GarminProduct = 1334
+	GarminProduct_Alf04                      GarminProduct = 1341
+	GarminProduct_Fr610                      GarminProduct = 1345
+	GarminProduct_Fr210Japan                 GarminProduct = 1360
+	GarminProduct_VectorSs                   GarminProduct = 1380
+	GarminProduct_VectorCp                   GarminProduct = 1381
+	GarminProduct_Edge800China               GarminProduct = 1386
+	GarminProduct_Edge500China               GarminProduct = 1387
+	GarminProduct_ApproachG10                GarminProduct = 1405
+	GarminProduct_Fr610Japan                 GarminProduct = 1410
+	GarminProduct_Edge500Korea               GarminProduct = 1422
+	GarminProduct_Fr70                       GarminProduct = 1436
+	GarminProduct_Fr310xt4t                  GarminProduct = 1446
+	GarminProduct_Amx                        GarminProduct = 1461
+	GarminProduct_Fr10                       GarminProduct = 1482
+	GarminProduct_Edge800Korea               GarminProduct = 1497
+	GarminProduct_Swim                       GarminProduct = 1499
+	GarminProduct_Fr910xtChina               GarminProduct = 1537
+	GarminProduct_Fenix                      GarminProduct = 1551
+	GarminProduct_Edge200Taiwan              GarminProduct = 1555
+	GarminProduct_Edge510                    GarminProduct = 1561
+	GarminProduct_Edge810                    GarminProduct = 1567
+	GarminProduct_Tempe                      GarminProduct = 1570
+	GarminProduct_Fr910xtJapan               GarminProduct = 1600
+	GarminProduct_Fr620                      GarminProduct = 1623
+	GarminProduct_Fr220                      GarminProduct = 1632
+	GarminProduct_Fr910xtKorea               GarminProduct = 1664
+	GarminProduct_Fr10Japan                  GarminProduct = 1688
+	GarminProduct_Edge810Japan               GarminProduct = 1721
+	GarminProduct_VirbElite                  GarminProduct = 1735
+	GarminProduct_EdgeTouring                GarminProduct = 1736
+	GarminProduct_Edge510Japan               GarminProduct = 1742
+	GarminProduct_HrmTri                     GarminProduct = 1743
+	GarminProduct_HrmRun                     GarminProduct = 1752
+	GarminProduct_Fr920xt                    GarminProduct = 1765
+	GarminProduct_Edge510Asia                GarminProduct = 1821
+	GarminProduct_Edge810China               GarminProduct = 1822
+	GarminProduct_Edge810Taiwan              GarminProduct = 1823
+	GarminProduct_Edge1000                   GarminProduct = 1836
+	GarminProduct_VivoFit                    GarminProduct = 1837
+	GarminProduct_VirbRemote                 GarminProduct = 1853
+	GarminProduct_VivoKi                     GarminProduct = 1885
+	GarminProduct_Fr15                       GarminProduct = 1903
+	GarminProduct_VivoActive                 GarminProduct = 1907
+	GarminProduct_Edge510Korea               GarminProduct = 1918
+	GarminProduct_Fr620Japan                 GarminProduct = 1928
+	GarminProduct_Fr620China                 GarminProduct = 1929
+	GarminProduct_Fr220Japan                 GarminProduct = 1930
+	GarminProduct_Fr220China                 GarminProduct = 1931
+	GarminProduct_ApproachS6                 GarminProduct = 1936
+	GarminProduct_VivoSmart                  GarminProduct = 1956
+	GarminProduct_Fenix2                     GarminProduct = 1967
+	GarminProduct_Epix                       GarminProduct = 1988
+	GarminProduct_Fenix3                     GarminProduct = 2050
+	GarminProduct_Edge1000Taiwan             GarminProduct = 2052
+	GarminProduct_Edge1000Japan              GarminProduct = 2053
+	GarminProduct_Fr15Japan                  GarminProduct = 2061
+	GarminProduct_Edge520                    GarminProduct = 2067
+	GarminProduct_Edge1000China              GarminProduct = 2070
+	GarminProduct_Fr620Russia                GarminProduct = 2072
+	GarminProduct_Fr220Russia                GarminProduct = 2073
+	GarminProduct_VectorS                    GarminProduct = 2079
+	GarminProduct_Edge1000Korea              GarminProduct = 2100
+	GarminProduct_Fr920xtTaiwan              GarminProduct = 2130
+	GarminProduct_Fr920xtChina               GarminProduct = 2131
+	GarminProduct_Fr920xtJapan               GarminProduct = 2132
+	GarminProduct_Virbx                      GarminProduct = 2134
+	GarminProduct_VivoSmartApac              GarminProduct = 2135
+	GarminProduct_EtrexTouch                 GarminProduct = 2140
+	GarminProduct_Edge25                     GarminProduct = 2147
+	GarminProduct_Fr25                       GarminProduct = 2148
+	GarminProduct_VivoFit2                   GarminProduct = 2150
+	GarminProduct_Fr225                      GarminProduct = 2153
+	GarminProduct_Fr630                      GarminProduct = 2156
+	GarminProduct_Fr230                      GarminProduct = 2157
+	GarminProduct_Fr735xt                    GarminProduct = 2158
+	GarminProduct_VivoActiveApac             GarminProduct = 2160
+	GarminProduct_Vector2                    GarminProduct = 2161
+	GarminProduct_Vector2s                   GarminProduct = 2162
+	GarminProduct_Virbxe                     GarminProduct = 2172
+	GarminProduct_Fr620Taiwan                GarminProduct = 2173
+	GarminProduct_Fr220Taiwan                GarminProduct = 2174
+	GarminProduct_Truswing                   GarminProduct = 2175
+	GarminProduct_Fenix3China                GarminProduct = 2188
+	GarminProduct_Fenix3Twn                  GarminProduct = 2189
+	GarminProduct_VariaHeadlight             GarminProduct = 2192
+	GarminProduct_VariaTaillightOld          GarminProduct = 2193
+	GarminProduct_EdgeExplore1000            GarminProduct = 2204
+	GarminProduct_Fr225Asia                  GarminProduct = 2219
+	GarminProduct_VariaRadarTaillight        GarminProduct = 2225
+	GarminProduct_VariaRadarDisplay          GarminProduct = 2226
+	GarminProduct_Edge20                     GarminProduct = 2238
+	GarminProduct_D2Bravo                    GarminProduct = 2262
+	GarminProduct_ApproachS20                GarminProduct = 2266
+	GarminProduct_VariaRemote                GarminProduct = 2276
+	GarminProduct_Hrm4Run                    GarminProduct = 2327
+	GarminProduct_VivoActiveHr               GarminProduct = 2337
+	GarminProduct_VivoSmartGpsHr             GarminProduct = 2347
+	GarminProduct_VivoSmartHr                GarminProduct = 2348
+	GarminProduct_VivoMove                   GarminProduct = 2368
+	GarminProduct_VariaVision                GarminProduct = 2398
+	GarminProduct_VivoFit3                   GarminProduct = 2406
+	GarminProduct_Fenix3Hr                   GarminProduct = 2413
+	GarminProduct_IndexSmartScale            GarminProduct = 2429
+	GarminProduct_Fr235                      GarminProduct = 2431
+	GarminProduct_Oregon7xx                  GarminProduct = 2441
+	GarminProduct_Rino7xx                    GarminProduct = 2444
+	GarminProduct_Nautix                     GarminProduct = 2496
+	GarminProduct_Edge820                    GarminProduct = 2530
+	GarminProduct_EdgeExplore820             GarminProduct = 2531
+	GarminProduct_Fenix5s                    GarminProduct = 2544
+	GarminProduct_D2BravoTitanium            GarminProduct = 2547
+	GarminProduct_VariaUt800                 GarminProduct = 2567
+	GarminProduct_RunningDynamicsPod         GarminProduct = 2593
+	GarminProduct_Fenix5x                    GarminProduct = 2604
+	GarminProduct_VivoFitJr                  GarminProduct = 2606
+	GarminProduct_VivoSmart3                 GarminProduct = 2622
+	GarminProduct_VivoSport                  GarminProduct = 2623
+	GarminProduct_ApproachS60                GarminProduct = 2656
+	GarminProduct_Virb360                    GarminProduct = 2687
+	GarminProduct_Fr935                      GarminProduct = 2691
+	GarminProduct_Fenix5                     GarminProduct = 2697
+	GarminProduct_Vivoactive3                GarminProduct = 2700
+	GarminProduct_Edge1030                   GarminProduct = 2713
+	GarminProduct_Vector3                    GarminProduct = 2787
+	GarminProduct_ApproachZ80                GarminProduct = 2806
+	GarminProduct_D2charlie                  GarminProduct = 2819
+	GarminProduct_Descent                    GarminProduct = 2859
+	GarminProduct_VivoFit4                   GarminProduct = 2878
+	GarminProduct_Fr645                      GarminProduct = 2886
+	GarminProduct_Fr645m                     GarminProduct = 2888
+	GarminProduct_Fr30                       GarminProduct = 2891
+	GarminProduct_Fenix5sPlus                GarminProduct = 2900
+	GarminProduct_Edge130                    GarminProduct = 2909
+	GarminProduct_Vivosmart4                 GarminProduct = 2927
+	GarminProduct_ApproachX10                GarminProduct = 2962
+	GarminProduct_Vivoactive3mW              GarminProduct = 2988
+	GarminProduct_EdgeExplore                GarminProduct = 3011
+	GarminProduct_Gpsmap66                   GarminProduct = 3028
+	GarminProduct_ApproachS10                GarminProduct = 3049
+	GarminProduct_Vivoactive3mL              GarminProduct = 3066
+	GarminProduct_ApproachG80                GarminProduct = 3085
+	GarminProduct_Fenix5Plus                 GarminProduct = 3110
+	GarminProduct_Fenix5xPlus                GarminProduct = 3111
+	GarminProduct_Edge520Plus                GarminProduct = 3112
+	GarminProduct_Fr945                      GarminProduct = 3113
+	GarminProduct_Edge530                    GarminProduct = 3121
+	GarminProduct_Edge830                    GarminProduct = 3122
+	GarminProduct_InstinctEsports            GarminProduct = 3126
+	GarminProduct_Gen3Bsm                    GarminProduct = 3192
+	GarminProduct_Gen3Bcm                    GarminProduct = 3193
+	GarminProduct_Vivoactive4Small           GarminProduct = 3224
+	GarminProduct_Vivoactive4Large           GarminProduct = 3225
+	GarminProduct_Venu                       GarminProduct = 3226
+	GarminProduct_DescentMk2                 GarminProduct = 3258
+	GarminProduct_Gpsmap66i                  GarminProduct = 3284
+	GarminProduct_Fenix6SSport               GarminProduct = 3287
+	GarminProduct_Fenix6S                    GarminProduct = 3288
+	GarminProduct_Fenix6Sport                GarminProduct = 3289
+	GarminProduct_Fenix6                     GarminProduct = 3290
+	GarminProduct_Fenix6x                    GarminProduct = 3291
+	GarminProduct_HrmDual                    GarminProduct = 3299
+	GarminProduct_HrmPro                     GarminProduct = 3300
+	GarminProduct_ApproachS40                GarminProduct = 3314
+	GarminProduct_VivoMove3                  GarminProduct = 3378
+	GarminProduct_Swim2                      GarminProduct = 3405
+	GarminProduct_InstinctSolar              GarminProduct = 3466
+	GarminProduct_Edge130Plus                GarminProduct = 3558
+	GarminProduct_Edge1030Plus               GarminProduct = 3570
+	GarminProduct_Rally200                   GarminProduct = 3578
+	GarminProduct_Fr745                      GarminProduct = 3589
+	GarminProduct_Venusq                     GarminProduct = 3600
+	GarminProduct_Enduro                     GarminProduct = 3638
+	GarminProduct_Venu2                      GarminProduct = 3703
+	GarminProduct_Venu2s                     GarminProduct = 3704
+	GarminProduct_Sdm4                       GarminProduct = 10007
+	GarminProduct_EdgeRemote                 GarminProduct = 10014
+	GarminProduct_TrainingCenter             GarminProduct = 20119
+	GarminProduct_ConnectiqSimulator         GarminProduct = 65531
+	GarminProduct_AndroidAntplusPlugin       GarminProduct = 65532
+	GarminProduct_Connect                    GarminProduct = 65534
+	GarminProduct_Invalid                    GarminProduct = 0xFFFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v GarminProduct) String() string {
+	return enumString("garmin_product", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v GarminProduct) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("garmin_product", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *GarminProduct) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("garmin_product", data)
+	if err != nil {
+		return err
+	}
+	*v = GarminProduct(value)
+	return nil
+}
+
+// FaveroProduct is the favero_product type of the Global FIT Profile.
+type FaveroProduct uint16
+
+const (
+	FaveroProduct_AssiomaUno FaveroProduct = 10
+	FaveroProduct_AssiomaDuo FaveroProduct = 12
+	FaveroProduct_Invalid    FaveroProduct = 0xFFFF
+)
+
+// String returns the profile name of v, or unknown_ followed by its value.
+func (v FaveroProduct) String() string {
+	return enumString("favero_product", uint64(v))
+}
+
+// MarshalJSON writes v as its profile name, or as a number when the
+// profile does not name it.
+func (v FaveroProduct) MarshalJSON() ([]byte, error) {
+	return enumMarshalJSON("favero_product", uint64(v))
+}
+
+// UnmarshalJSON reads v from a profile name or a number.
+func (v *FaveroProduct) UnmarshalJSON(data []byte) error {
+	value, err := enumUnmarshalJSON("favero_product", data)
+	if err != nil {
+		return err
+	}
+	*v = FaveroProduct(value)
+	return nil
+}
+
+// ProfileTypes maps the name of each type of the Global FIT Profile to
+// its definition.
+var ProfileTypes = map[string]*ProfileType{
+	"file": {
+		Name:     "file",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			1:   "device",
+			2:   "settings",
+			3:   "sport",
+			4:   "activity",
+			5:   "workout",
+			6:   "course",
+			7:   "schedules",
+			9:   "weight",
+			10:  "totals",
+			11:  "goals",
+			14:  "blood_pressure",
+			15:  "monitoring_a",
+			20:  "activity_summary",
+			28:  "monitoring_daily",
+			32:  "monitoring_b",
+			34:  "segment",
+			35:  "segment_list",
+			40:  "exd_configuration",
+			247: "mfg_range_min",
+			254: "mfg_range_max",
+		},
+	},
+	"mesg_num": {
+		Name:     "mesg_num",
+		BaseType: BaseTypeNumber_Uint16,
+		Values: map[uint64]string{
+			0:     "file_id",
+			1:     "capabilities",
+			2:     "device_settings",
+			3:     "user_profile",
+			4:     "hrm_profile",
+			5:     "sdm_profile",
+			6:     "bike_profile",
+			7:     "zones_target",
+			8:     "hr_zone",
+			9:     "power_zone",
+			10:    "met_zone",
+			12:    "sport",
+			15:    "goal",
+			18:    "session",
+			19:    "lap",
+			20:    "record",
+			21:    "event",
+			23:    "device_info",
+			26:    "workout",
+			27:    "workout_step",
+			28:    "schedule",
+			30:    "weight_scale",
+			31:    "course",
+			32:    "course_point",
+			33:    "totals",
+			34:    "activity",
+			35:    "software",
+			37:    "file_capabilities",
+			38:    "mesg_capabilities",
+			39:    "field_capabilities",
+			49:    "file_creator",
+			51:    "blood_pressure",
+			53:    "speed_zone",
+			55:    "monitoring",
+			72:    "training_file",
+			78:    "hrv",
+			80:    "ant_rx",
+			81:    "ant_tx",
+			82:    "ant_channel_id",
+			101:   "length",
+			103:   "monitoring_info",
+			105:   "pad",
+			106:   "slave_device",
+			127:   "connectivity",
+			128:   "weather_conditions",
+			129:   "weather_alert",
+			131:   "cadence_zone",
+			132:   "hr",
+			142:   "segment_lap",
+			145:   "memo_glob",
+			148:   "segment_id",
+			149:   "segment_leaderboard_entry",
+			150:   "segment_point",
+			151:   "segment_file",
+			158:   "workout_session",
+			159:   "watchface_settings",
+			160:   "gps_metadata",
+			161:   "camera_event",
+			162:   "timestamp_correlation",
+			164:   "gyroscope_data",
+			165:   "accelerometer_data",
+			167:   "three_d_sensor_calibration",
+			169:   "video_frame",
+			174:   "obdii_data",
+			177:   "nmea_sentence",
+			178:   "aviation_attitude",
+			184:   "video",
+			185:   "video_title",
+			186:   "video_description",
+			187:   "video_clip",
+			188:   "ohr_settings",
+			200:   "exd_screen_configuration",
+			201:   "exd_data_field_configuration",
+			202:   "exd_data_concept_configuration",
+			206:   "field_description",
+			207:   "developer_data_id",
+			208:   "magnetometer_data",
+			209:   "barometer_data",
+			210:   "one_d_sensor_calibration",
+			225:   "set",
+			227:   "stress_level",
+			258:   "dive_settings",
+			259:   "dive_gas",
+			262:   "dive_alarm",
+			264:   "exercise_title",
+			268:   "dive_summary",
+			285:   "jump",
+			317:   "climb_pro",
+			65280: "mfg_range_min",
+			65534: "mfg_range_max",
+		},
+	},
+	"fit_base_type": {
+		Name:     "fit_base_type",
+		BaseType: BaseTypeNumber_Uint8,
+		Values: map[uint64]string{
+			0:   "enum",
+			1:   "sint8",
+			2:   "uint8",
+			131: "sint16",
+			132: "uint16",
+			133: "sint32",
+			134: "uint32",
+			7:   "string",
+			136: "float32",
+			137: "float64",
+			10:  "uint8z",
+			139: "uint16z",
+			140: "uint32z",
+			13:  "byte",
+			142: "sint64",
+			143: "uint64",
+			144: "uint64z",
+		},
+	},
+	"date_time": {
+		Name:     "date_time",
+		BaseType: BaseTypeNumber_Uint32,
+		Values: map[uint64]string{
+			268435456: "min",
+		},
+	},
+	"local_date_time": {
+		Name:     "local_date_time",
+		BaseType: BaseTypeNumber_Uint32,
+		Values: map[uint64]string{
+			268435456: "min",
+		},
+	},
+	"message_index": {
+		Name:     "message_index",
+		BaseType: BaseTypeNumber_Uint16,
+		Enum:     true,
+		Values: map[uint64]string{
+			32768: "selected",
+			28672: "reserved",
+			4095:  "mask",
+		},
+	},
+	"device_index": {
+		Name:     "device_index",
+		BaseType: BaseTypeNumber_Uint8,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "creator",
+		},
+	},
+	"gender": {
+		Name:     "gender",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "female",
+			1: "male",
+		},
+	},
+	"display_measure": {
+		Name:     "display_measure",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "metric",
+			1: "statute",
+			2: "nautical",
+		},
+	},
+	"sport": {
+		Name:     "sport",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0:   "generic",
+			1:   "running",
+			2:   "cycling",
+			3:   "transition",
+			4:   "fitness_equipment",
+			5:   "swimming",
+			6:   "basketball",
+			7:   "soccer",
+			8:   "tennis",
+			9:   "american_football",
+			10:  "training",
+			11:  "walking",
+			12:  "cross_country_skiing",
+			13:  "alpine_skiing",
+			14:  "snowboarding",
+			15:  "rowing",
+			16:  "mountaineering",
+			17:  "hiking",
+			18:  "multisport",
+			19:  "paddling",
+			20:  "flying",
+			21:  "e_biking",
+			22:  "motorcycling",
+			23:  "boating",
+			24:  "driving",
+			25:  "golf",
+			26:  "hang_gliding",
+			27:  "horseback_riding",
+			28:  "hunting",
+			29:  "fishing",
+			30:  "inline_skating",
+			31:  "rock_climbing",
+			32:  "sailing",
+			33:  "ice_skating",
+			34:  "sky_diving",
+			35:  "snowshoeing",
+			36:  "snowmobiling",
+			37:  "stand_up_paddleboarding",
+			38:  "surfing",
+			39:  "wakeboarding",
+			40:  "water_skiing",
+			41:  "kayaking",
+			42:  "rafting",
+			43:  "windsurfing",
+			44:  "kitesurfing",
+			45:  "tactical",
+			46:  "jumpmaster",
+			47:  "boxing",
+			48:  "floor_climbing",
+			53:  "diving",
+			254: "all",
+		},
+	},
+	"sub_sport": {
+		Name:     "sub_sport",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0:   "generic",
+			1:   "treadmill",
+			2:   "street",
+			3:   "trail",
+			4:   "track",
+			5:   "spin",
+			6:   "indoor_cycling",
+			7:   "road",
+			8:   "mountain",
+			9:   "downhill",
+			10:  "recumbent",
+			11:  "cyclocross",
+			12:  "hand_cycling",
+			13:  "track_cycling",
+			14:  "indoor_rowing",
+			15:  "elliptical",
+			16:  "stair_climbing",
+			17:  "lap_swimming",
+			18:  "open_water",
+			19:  "flexibility_training",
+			20:  "strength_training",
+			21:  "warm_up",
+			22:  "match",
+			23:  "exercise",
+			24:  "challenge",
+			25:  "indoor_skiing",
+			26:  "cardio_training",
+			27:  "indoor_walking",
+			28:  "e_bike_fitness",
+			29:  "bmx",
+			30:  "casual_walking",
+			31:  "speed_walking",
+			32:  "bike_to_run_transition",
+			33:  "run_to_bike_transition",
+			34:  "swim_to_bike_transition",
+			35:  "atv",
+			36:  "motocross",
+			37:  "backcountry",
+			38:  "resort",
+			39:  "rc_drone",
+			40:  "wingsuit",
+			41:  "whitewater",
+			42:  "skate_skiing",
+			43:  "yoga",
+			44:  "pilates",
+			45:  "indoor_running",
+			46:  "gravel_cycling",
+			47:  "e_bike_mountain",
+			48:  "commuting",
+			49:  "mixed_surface",
+			50:  "navigate",
+			51:  "track_me",
+			52:  "map",
+			53:  "single_gas_diving",
+			54:  "multi_gas_diving",
+			55:  "gauge_diving",
+			56:  "apnea_diving",
+			57:  "apnea_hunting",
+			58:  "virtual_activity",
+			59:  "obstacle",
+			62:  "breathing",
+			65:  "sail_race",
+			67:  "ultra",
+			68:  "indoor_climbing",
+			69:  "bouldering",
+			254: "all",
+		},
+	},
+	"activity": {
+		Name:     "activity",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "manual",
+			1: "auto_multi_sport",
+		},
+	},
+	"intensity": {
+		Name:     "intensity",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "active",
+			1: "rest",
+			2: "warmup",
+			3: "cooldown",
+			4: "recovery",
+			5: "interval",
+			6: "other",
+		},
+	},
+	"session_trigger": {
+		Name:     "session_trigger",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "activity_end",
+			1: "manual",
+			2: "auto_multi_sport",
+			3: "fitness_equipment",
+		},
+	},
+	"lap_trigger": {
+		Name:     "lap_trigger",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "manual",
+			1: "time",
+			2: "distance",
+			3: "position_start",
+			4: "position_lap",
+			5: "position_waypoint",
+			6: "position_marked",
+			7: "session_end",
+			8: "fitness_equipment",
+		},
+	},
+	"timer_trigger": {
+		Name:     "timer_trigger",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "manual",
+			1: "auto",
+			2: "fitness_equipment",
+		},
+	},
+	"event": {
+		Name:     "event",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0:  "timer",
+			3:  "workout",
+			4:  "workout_step",
+			5:  "power_down",
+			6:  "power_up",
+			7:  "off_course",
+			8:  "session",
+			9:  "lap",
+			10: "course_point",
+			11: "battery",
+			12: "virtual_partner_pace",
+			13: "hr_high_alert",
+			14: "hr_low_alert",
+			15: "speed_high_alert",
+			16: "speed_low_alert",
+			17: "cad_high_alert",
+			18: "cad_low_alert",
+			19: "power_high_alert",
+			20: "power_low_alert",
+			21: "recovery_hr",
+			22: "battery_low",
+			23: "time_duration_alert",
+			24: "distance_duration_alert",
+			25: "calorie_duration_alert",
+			26: "activity",
+			27: "fitness_equipment",
+			28: "length",
+			32: "user_marker",
+			33: "sport_point",
+			36: "calibration",
+			42: "front_gear_change",
+			43: "rear_gear_change",
+			44: "rider_position_change",
+			45: "elev_high_alert",
+			46: "elev_low_alert",
+			47: "comm_timeout",
+		},
+	},
+	"event_type": {
+		Name:     "event_type",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "start",
+			1: "stop",
+			2: "consecutive_depreciated",
+			3: "marker",
+			4: "stop_all",
+			5: "begin_depreciated",
+			6: "end_depreciated",
+			7: "end_all_depreciated",
+			8: "stop_disable",
+			9: "stop_disable_all",
+		},
+	},
+	"rider_position_type": {
+		Name:     "rider_position_type",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "seated",
+			1: "standing",
+			2: "transition_to_seated",
+			3: "transition_to_standing",
+		},
+	},
+	"comm_timeout_type": {
+		Name:     "comm_timeout_type",
+		BaseType: BaseTypeNumber_Uint16,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "wildcard_pairing_timeout",
+			1: "pairing_timeout",
+			2: "connection_lost",
+			3: "connection_timeout",
+		},
+	},
+	"battery_status": {
+		Name:     "battery_status",
+		BaseType: BaseTypeNumber_Uint8,
+		Enum:     true,
+		Values: map[uint64]string{
+			1: "new",
+			2: "good",
+			3: "ok",
+			4: "low",
+			5: "critical",
+			6: "charging",
+			7: "unknown",
+		},
+	},
+	"hr_type": {
+		Name:     "hr_type",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "normal",
+			1: "irregular",
+		},
+	},
+	"source_type": {
+		Name:     "source_type",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "ant",
+			1: "antplus",
+			2: "bluetooth",
+			3: "bluetooth_low_energy",
+			4: "wifi",
+			5: "local",
+		},
+	},
+	"antplus_device_type": {
+		Name:     "antplus_device_type",
+		BaseType: BaseTypeNumber_Uint8,
+		Enum:     true,
+		Values: map[uint64]string{
+			1:   "antfs",
+			11:  "bike_power",
+			12:  "environment_sensor_legacy",
+			15:  "multi_sport_speed_distance",
+			16:  "control",
+			17:  "fitness_equipment",
+			18:  "blood_pressure",
+			19:  "geocache_node",
+			20:  "light_electric_vehicle",
+			25:  "env_sensor",
+			26:  "racquet",
+			27:  "control_hub",
+			31:  "muscle_oxygen",
+			34:  "shifting",
+			35:  "bike_light_main",
+			36:  "bike_light_shared",
+			38:  "exd",
+			40:  "bike_radar",
+			46:  "bike_aero",
+			119: "weight_scale",
+			120: "heart_rate",
+			121: "bike_speed_cadence",
+			122: "bike_cadence",
+			123: "bike_speed",
+			124: "stride_speed_distance",
+		},
+	},
+	"ant_network": {
+		Name:     "ant_network",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "public",
+			1: "antplus",
+			2: "antfs",
+			3: "private",
+		},
+	},
+	"swim_stroke": {
+		Name:     "swim_stroke",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "freestyle",
+			1: "backstroke",
+			2: "breaststroke",
+			3: "butterfly",
+			4: "drill",
+			5: "mixed",
+			6: "im",
+		},
+	},
+	"length_type": {
+		Name:     "length_type",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0: "idle",
+			1: "active",
+		},
+	},
+	"activity_type": {
+		Name:     "activity_type",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0:   "generic",
+			1:   "running",
+			2:   "cycling",
+			3:   "transition",
+			4:   "fitness_equipment",
+			5:   "swimming",
+			6:   "walking",
+			8:   "sedentary",
+			254: "all",
+		},
+	},
+	"wkt_step_duration": {
+		Name:     "wkt_step_duration",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0:  "time",
+			1:  "distance",
+			2:  "hr_less_than",
+			3:  "hr_greater_than",
+			4:  "calories",
+			5:  "open",
+			6:  "repeat_until_steps_cmplt",
+			7:  "repeat_until_time",
+			8:  "repeat_until_distance",
+			9:  "repeat_until_calories",
+			10: "repeat_until_hr_less_than",
+			11: "repeat_until_hr_greater_than",
+			12: "repeat_until_power_less_than",
+			13: "repeat_until_power_greater_than",
+			14: "power_less_than",
+			15: "power_greater_than",
+			16: "training_peaks_tss",
+			17: "repeat_until_power_last_lap_less_than",
+			18: "repeat_until_max_power_last_lap_less_than",
+			19: "power_3s_less_than",
+			20: "power_10s_less_than",
+			21: "power_30s_less_than",
+			22: "power_3s_greater_than",
+			23: "power_10s_greater_than",
+			24: "power_30s_greater_than",
+			25: "power_lap_less_than",
+			26: "power_lap_greater_than",
+			27: "repeat_until_training_peaks_tss",
+			28: "repetition_time",
+			29: "reps",
+			31: "time_only",
+		},
+	},
+	"wkt_step_target": {
+		Name:     "wkt_step_target",
+		BaseType: BaseTypeNumber_Enum,
+		Enum:     true,
+		Values: map[uint64]string{
+			0:  "speed",
+			1:  "heart_rate",
+			2:  "open",
+			3:  "cadence",
+			4:  "power",
+			5:  "grade",
+			6:  "resistance",
+			7:  "power_3s",
+			8:  "power_10s",
+			9:  "power_30s",
+			10: "power_lap",
+			11: "swim_stroke",
+			12: "speed_lap",
+			13: "heart_rate_lap",
+		},
+	},
+	"workout_hr": {
+		Name:     "workout_hr",
+		BaseType: BaseTypeNumber_Uint32,
+		Enum:     true,
+		Values: map[uint64]string{
+			100: "bpm_offset",
+		},
+	},
+	"workout_power": {
+		Name:     "workout_power",
+		BaseType: BaseTypeNumber_Uint32,
+		Enum:     true,
+		Values: map[uint64]string{
+			1000: "watts_offset",
+		},
+	},
+	"manufacturer": {
+		Name:     "manufacturer",
+		BaseType: BaseTypeNumber_Uint16,
+		Enum:     true,
+		Values: map[uint64]string{
+			1:    "garmin",
+			3:    "zephyr",
+			4:    "dayton",
+			5:    "idt",
+			6:    "srm",
+			7:    "quarq",
+			8:    "ibike",
+			9:    "saris",
+			10:   "spark_hk",
+			11:   "tanita",
+			12:   "echowell",
+			13:   "dynastream_oem",
+			14:   "nautilus",
+			15:   "dynastream",
+			16:   "timex",
+			17:   "metrigear",
+			18:   "xelic",
+			19:   "beurer",
+			20:   "cardiosport",
+			21:   "a_and_d",
+			22:   "hmm",
+			23:   "suunto",
+			24:   "thita_elektronik",
+			25:   "gpulse",
+			26:   "clean_mobile",
+			27:   "pedal_brain",
+			28:   "peaksware",
+			29:   "saxonar",
+			30:   "lemond_fitness",
+			31:   "dexcom",
+			32:   "wahoo_fitness",
+			33:   "octane_fitness",
+			34:   "archinoetics",
+			35:   "the_hurt_box",
+			36:   "citizen_systems",
+			37:   "magellan",
+			38:   "osynce",
+			39:   "holux",
+			40:   "concept2",
+			41:   "shimano",
+			42:   "one_giant_leap",
+			43:   "ace_sensor",
+			44:   "brim_brothers",
+			45:   "xplova",
+			46:   "perception_digital",
+			47:   "bf1systems",
+			48:   "pioneer",
+			49:   "spantec",
+			50:   "metalogics",
+			51:   "4iiiis",
+			52:   "seiko_epson",
+			53:   "seiko_epson_oem",
+			54:   "ifor_powell",
+			55:   "maxwell_guider",
+			56:   "star_trac",
+			57:   "breakaway",
+			58:   "alatech_technology_ltd",
+			59:   "mio_technology_europe",
+			60:   "rotor",
+			61:   "geonaute",
+			62:   "id_bike",
+			63:   "specialized",
+			64:   "wtek",
+			65:   "physical_enterprises",
+			66:   "north_pole_engineering",
+			67:   "bkool",
+			68:   "cateye",
+			69:   "stages_cycling",
+			70:   "sigmasport",
+			71:   "tomtom",
+			72:   "peripedal",
+			73:   "wattbike",
+			76:   "moxy",
+			77:   "ciclosport",
+			78:   "powerbahn",
+			79:   "acorn_projects_aps",
+			80:   "lifebeam",
+			81:   "bontrager",
+			82:   "wellgo",
+			83:   "scosche",
+			84:   "magura",
+			85:   "woodway",
+			86:   "elite",
+			87:   "nielsen_kellerman",
+			88:   "dk_city",
+			89:   "tacx",
+			90:   "direction_technology",
+			91:   "magtonic",
+			92:   "1partcarbon",
+			93:   "inside_ride_technologies",
+			94:   "sound_of_motion",
+			95:   "stryd",
+			96:   "icg",
+			97:   "mipulse",
+			98:   "bsx_athletics",
+			99:   "look",
+			100:  "campagnolo_srl",
+			101:  "body_bike_smart",
+			102:  "praxisworks",
+			103:  "limits_technology",
+			104:  "topaction_technology",
+			105:  "cosinuss",
+			106:  "fitcare",
+			107:  "magene",
+			108:  "giant_manufacturing_co",
+			109:  "tigrasport",
+			110:  "salutron",
+			111:  "technogym",
+			112:  "bryton_sensors",
+			113:  "latitude_limited",
+			114:  "soaring_technology",
+			115:  "igpsport",
+			116:  "thinkrider",
+			117:  "gopher_sport",
+			118:  "waterrower",
+			119:  "orangetheory",
+			120:  "inpeak",
+			121:  "kinetic",
+			122:  "johnson_health_tech",
+			123:  "polar_electro",
+			124:  "seesense",
+			125:  "nci_technology",
+			255:  "development",
+			257:  "healthandlife",
+			258:  "lezyne",
+			259:  "scribe_labs",
+			260:  "zwift",
+			261:  "watteam",
+			262:  "recon",
+			263:  "favero_electronics",
+			264:  "dynovelo",
+			265:  "strava",
+			266:  "precor",
+			267:  "bryton",
+			268:  "sram",
+			269:  "navman",
+			270:  "cobi",
+			271:  "spivi",
+			272:  "mio_magellan",
+			273:  "evesports",
+			274:  "sensitivus_gauge",
+			275:  "podoon",
+			276:  "life_time_fitness",
+			277:  "falco_e_motors",
+			278:  "minoura",
+			279:  "cycliq",
+			280:  "luxottica",
+			281:  "trainer_road",
+			282:  "the_sufferfest",
+			283:  "fullspeedahead",
+			284:  "virtualtraining",
+			285:  "feedbacksports",
+			286:  "omata",
+			287:  "vdo",
+			288:  "magneticdays",
+			289:  "hammerhead",
+			290:  "kinetic_by_kurt",
+			291:  "shapelog",
+			292:  "dabuziduo",
+			293:  "jetblack",
+			294:  "coros",
+			295:  "virtugo",
+			296:  "velosense",
+			297:  "cycligentinc",
+			298:  "trailforks",
+			299:  "mahle_ebikemotion",
+			300:  "nurvv",
+			301:  "microprogram",
+			302:  "zone5cloud",
+			303:  "greenteg",
+			304:  "yamaha_motors",
+			305:  "whoop",
+			306:  "gravaa",
+			307:  "onelap",
+			308:  "monark_exercise",
+			309:  "form",
+			310:  "decathlon",
+			311:  "syncros",
+			5759: "actigraphcorp",
+		},
+	},
+	"garmin_product": {
+		Name:     "garmin_product",
+		BaseType: BaseTypeNumber_Uint16,
+		Enum:     true,
+		Values: map[uint64]string{
+			1:     "hrm1",
+			2:     "axh01",
+			3:     "axb01",
+			4:     "axb02",
+			5:     "hrm2ss",
+			6:     "dsi_alf02",
+			7:     "hrm3ss",
+			8:     "hrm_run_single_byte_product_id",
+			9:     "bsm",
+			10:    "bcm",
+			11:    "axs01",
+			12:    "hrm_tri_single_byte_product_id",
+			13:    "hrm4_run_single_byte_product_id",
+			14:    "fr225_single_byte_product_id",
+			15:    "gen3_bsm_single_byte_product_id",
+			16:    "gen3_bcm_single_byte_product_id",
+			473:   "fr301_china",
+			474:   "fr301_japan",
+			475:   "fr301_korea",
+			494:   "fr301_taiwan",
+			717:   "fr405",
+			782:   "fr50",
+			987:   "fr405_japan",
+			988:   "fr60",
+			1011:  "dsi_alf01",
+			1018:  "fr310xt",
+			1036:  "edge500",
+			1124:  "fr110",
+			1169:  "edge800",
+			1199:  "edge500_taiwan",
+			1213:  "edge500_japan",
+			1253:  "chirp",
+			1274:  "fr110_japan",
+			1325:  "edge200",
+			1328:  "fr910xt",
+			1333:  "edge800_taiwan",
+			1334:  "edge800_japan",
+			1341:  "alf04",
+			1345:  "fr610",
+			1360:  "fr210_japan",
+			1380:  "vector_ss",
+			1381:  "vector_cp",
+			1386:  "edge800_china",
+			1387:  "edge500_china",
+			1405:  "approach_g10",
+			1410:  "fr610_japan",
+			1422:  "edge500_korea",
+			1436:  "fr70",
+			1446:  "fr310xt_4t",
+			1461:  "amx",
+			1482:  "fr10",
+			1497:  "edge800_korea",
+			1499:  "swim",
+			1537:  "fr910xt_china",
+			1551:  "fenix",
+			1555:  "edge200_taiwan",
+			1561:  "edge510",
+			1567:  "edge810",
+			1570:  "tempe",
+			1600:  "fr910xt_japan",
+			1623:  "fr620",
+			1632:  "fr220",
+			1664:  "fr910xt_korea",
+			1688:  "fr10_japan",
+			1721:  "edge810_japan",
+			1735:  "virb_elite",
+			1736:  "edge_touring",
+			1742:  "edge510_japan",
+			1743:  "hrm_tri",
+			1752:  "hrm_run",
+			1765:  "fr920xt",
+			1821:  "edge510_asia",
+			1822:  "edge810_china",
+			1823:  "edge810_taiwan",
+			1836:  "edge1000",
+			1837:  "vivo_fit",
+			1853:  "virb_remote",
+			1885:  "vivo_ki",
+			1903:  "fr15",
+			1907:  "vivo_active",
+			1918:  "edge510_korea",
+			1928:  "fr620_japan",
+			1929:  "fr620_china",
+			1930:  "fr220_japan",
+			1931:  "fr220_china",
+			1936:  "approach_s6",
+			1956:  "vivo_smart",
+			1967:  "fenix2",
+			1988:  "epix",
+			2050:  "fenix3",
+			2052:  "edge1000_taiwan",
+			2053:  "edge1000_japan",
+			2061:  "fr15_japan",
+			2067:  "edge520",
+			2070:  "edge1000_china",
+			2072:  "fr620_russia",
+			2073:  "fr220_russia",
+			2079:  "vector_s",
+			2100:  "edge1000_korea",
+			2130:  "fr920xt_taiwan",
+			2131:  "fr920xt_china",
+			2132:  "fr920xt_japan",
+			2134:  "virbx",
+			2135:  "vivo_smart_apac",
+			2140:  "etrex_touch",
+			2147:  "edge25",
+			2148:  "fr25",
+			2150:  "vivo_fit2",
+			2153:  "fr225",
+			2156:  "fr630",
+			2157:  "fr230",
+			2158:  "fr735xt",
+			2160:  "vivo_active_apac",
+			2161:  "vector_2",
+			2162:  "vector_2s",
+			2172:  "virbxe",
+			2173:  "fr620_taiwan",
+			2174:  "fr220_taiwan",
+			2175:  "truswing",
+			2188:  "fenix3_china",
+			2189:  "fenix3_twn",
+			2192:  "varia_headlight",
+			2193:  "varia_taillight_old",
+			2204:  "edge_explore_1000",
+			2219:  "fr225_asia",
+			2225:  "varia_radar_taillight",
+			2226:  "varia_radar_display",
+			2238:  "edge20",
+			2262:  "d2_bravo",
+			2266:  "approach_s20",
+			2276:  "varia_remote",
+			2327:  "hrm4_run",
+			2337:  "vivo_active_hr",
+			2347:  "vivo_smart_gps_hr",
+			2348:  "vivo_smart_hr",
+			2368:  "vivo_move",
+			2398:  "varia_vision",
+			2406:  "vivo_fit3",
+			2413:  "fenix3_hr",
+			2429:  "index_smart_scale",
+			2431:  "fr235",
+			2441:  "oregon7xx",
+			2444:  "rino7xx",
+			2496:  "nautix",
+			2530:  "edge_820",
+			2531:  "edge_explore_820",
+			2544:  "fenix5s",
+			2547:  "d2_bravo_titanium",
+			2567:  "varia_ut800",
+			2593:  "running_dynamics_pod",
+			2604:  "fenix5x",
+			2606:  "vivo_fit_jr",
+			2622:  "vivo_smart3",
+			2623:  "vivo_sport",
+			2656:  "approach_s60",
+			2687:  "virb_360",
+			2691:  "fr935",
+			2697:  "fenix5",
+			2700:  "vivoactive3",
+			2713:  "edge_1030",
+			2787:  "vector_3",
+			2806:  "approach_z80",
+			2819:  "d2charlie",
+			2859:  "descent",
+			2878:  "vivo_fit4",
+			2886:  "fr645",
+			2888:  "fr645m",
+			2891:  "fr30",
+			2900:  "fenix5s_plus",
+			2909:  "edge_130",
+			2927:  "vivosmart_4",
+			2962:  "approach_x10",
+			2988:  "vivoactive3m_w",
+			3011:  "edge_explore",
+			3028:  "gpsmap66",
+			3049:  "approach_s10",
+			3066:  "vivoactive3m_l",
+			3085:  "approach_g80",
+			3110:  "fenix5_plus",
+			3111:  "fenix5x_plus",
+			3112:  "edge_520_plus",
+			3113:  "fr945",
+			3121:  "edge_530",
+			3122:  "edge_830",
+			3126:  "instinct_esports",
+			3192:  "gen3_bsm",
+			3193:  "gen3_bcm",
+			3224:  "vivoactive4_small",
+			3225:  "vivoactive4_large",
+			3226:  "venu",
+			3258:  "descent_mk2",
+			3284:  "gpsmap66i",
+			3287:  "fenix6S_sport",
+			3288:  "fenix6S",
+			3289:  "fenix6_sport",
+			3290:  "fenix6",
+			3291:  "fenix6x",
+			3299:  "hrm_dual",
+			3300:  "hrm_pro",
+			3314:  "approach_s40",
+			3378:  "vivo_move3",
+			3405:  "swim2",
+			3466:  "instinct_solar",
+			3558:  "edge_130_plus",
+			3570:  "edge_1030_plus",
+			3578:  "rally_200",
+			3589:  "fr745",
+			3600:  "venusq",
+			3638:  "enduro",
+			3703:  "venu2",
+			3704:  "venu2s",
+			10007: "sdm4",
+			10014: "edge_remote",
+			20119: "training_center",
+			65531: "connectiq_simulator",
+			65532: "android_antplus_plugin",
+			65534: "connect",
+		},
+	},
+	"favero_product": {
+		Name:     "favero_product",
+		BaseType: BaseTypeNumber_Uint16,
+		Enum:     true,
+		Values: map[uint64]string{
+			10: "assioma_uno",
+			12: "assioma_duo",
+		},
+	},
+}
+
+// ProfileMessages maps each message of the Global FIT Profile to its
+// definition.
+var ProfileMessages = map[GlobalMessageType]*ProfileMessage{
+	GlobalMessageType_FileId: {
+		Name: "file_id",
+		Type: GlobalMessageType_FileId,
+		Fields: map[uint8]*ProfileField{
+			0: {
+				Number:   0,
+				Name:     "type",
+				Type:     "file",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "manufacturer",
+				Type:     "manufacturer",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "product",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "favero_product",
+						Type:     "favero_product",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 1, Value: 263},
+						},
+					},
+					{
+						Name:     "garmin_product",
+						Type:     "garmin_product",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 1, Value: 1},
+							{Number: 1, Value: 15},
+							{Number: 1, Value: 13},
+							{Number: 1, Value: 89},
+						},
+					},
+				},
+			},
+			3: {
+				Number:   3,
+				Name:     "serial_number",
+				Type:     "uint32z",
+				BaseType: BaseTypeNumber_Uint32z,
+				Scale:    1,
+			},
+			4: {
+				Number:   4,
+				Name:     "time_created",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+			},
+			5: {
+				Number:   5,
+				Name:     "number",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			8: {
+				Number:   8,
+				Name:     "product_name",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_FileCreator: {
+		Name: "file_creator",
+		Type: GlobalMessageType_FileCreator,
+		Fields: map[uint8]*ProfileField{
+			0: {
+				Number:   0,
+				Name:     "software_version",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "hardware_version",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_Software: {
+		Name: "software",
+		Type: GlobalMessageType_Software,
+		Fields: map[uint8]*ProfileField{
+			254: {
+				Number:   254,
+				Name:     "message_index",
+				Type:     "message_index",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "version",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    100,
+			},
+			5: {
+				Number:   5,
+				Name:     "part_number",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_UserProfile: {
+		Name: "user_profile",
+		Type: GlobalMessageType_UserProfile,
+		Fields: map[uint8]*ProfileField{
+			254: {
+				Number:   254,
+				Name:     "message_index",
+				Type:     "message_index",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			0: {
+				Number:   0,
+				Name:     "friendly_name",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "gender",
+				Type:     "gender",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "age",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "years",
+			},
+			3: {
+				Number:   3,
+				Name:     "height",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    100,
+				Units:    "m",
+			},
+			4: {
+				Number:   4,
+				Name:     "weight",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    10,
+				Units:    "kg",
+			},
+			8: {
+				Number:   8,
+				Name:     "resting_heart_rate",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "bpm",
+			},
+			11: {
+				Number:   11,
+				Name:     "default_max_heart_rate",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "bpm",
+			},
+		},
+	},
+	GlobalMessageType_Sport: {
+		Name: "sport",
+		Type: GlobalMessageType_Sport,
+		Fields: map[uint8]*ProfileField{
+			0: {
+				Number:   0,
+				Name:     "sport",
+				Type:     "sport",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "sub_sport",
+				Type:     "sub_sport",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "name",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_Workout: {
+		Name: "workout",
+		Type: GlobalMessageType_Workout,
+		Fields: map[uint8]*ProfileField{
+			4: {
+				Number:   4,
+				Name:     "sport",
+				Type:     "sport",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			6: {
+				Number:   6,
+				Name:     "num_valid_steps",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			8: {
+				Number:   8,
+				Name:     "wkt_name",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			11: {
+				Number:   11,
+				Name:     "sub_sport",
+				Type:     "sub_sport",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			14: {
+				Number:   14,
+				Name:     "pool_length",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    100,
+				Units:    "m",
+			},
+			15: {
+				Number:   15,
+				Name:     "pool_length_unit",
+				Type:     "display_measure",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_WorkoutStep: {
+		Name: "workout_step",
+		Type: GlobalMessageType_WorkoutStep,
+		Fields: map[uint8]*ProfileField{
+			254: {
+				Number:   254,
+				Name:     "message_index",
+				Type:     "message_index",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			0: {
+				Number:   0,
+				Name:     "wkt_step_name",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "duration_type",
+				Type:     "wkt_step_duration",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "duration_value",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "duration_time",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1000,
+						Units:    "s",
+						References: []ProfileReference{
+							{Number: 1, Value: 0},
+							{Number: 1, Value: 28},
+						},
+					},
+					{
+						Name:     "duration_distance",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    100,
+						Units:    "m",
+						References: []ProfileReference{
+							{Number: 1, Value: 1},
+						},
+					},
+					{
+						Name:     "duration_hr",
+						Type:     "workout_hr",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "% or bpm",
+						References: []ProfileReference{
+							{Number: 1, Value: 2},
+							{Number: 1, Value: 3},
+						},
+					},
+					{
+						Name:     "duration_calories",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "calories",
+						References: []ProfileReference{
+							{Number: 1, Value: 4},
+						},
+					},
+					{
+						Name:     "duration_step",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 1, Value: 6},
+							{Number: 1, Value: 7},
+							{Number: 1, Value: 8},
+							{Number: 1, Value: 9},
+							{Number: 1, Value: 10},
+							{Number: 1, Value: 11},
+							{Number: 1, Value: 12},
+							{Number: 1, Value: 13},
+							{Number: 1, Value: 27},
+						},
+					},
+					{
+						Name:     "duration_power",
+						Type:     "workout_power",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "% or watts",
+						References: []ProfileReference{
+							{Number: 1, Value: 14},
+							{Number: 1, Value: 15},
+						},
+					},
+					{
+						Name:     "duration_reps",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 1, Value: 29},
+						},
+					},
+				},
+			},
+			3: {
+				Number:   3,
+				Name:     "target_type",
+				Type:     "wkt_step_target",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			4: {
+				Number:   4,
+				Name:     "target_value",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "target_speed_zone",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 3, Value: 0},
+						},
+					},
+					{
+						Name:     "target_hr_zone",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 3, Value: 1},
+						},
+					},
+					{
+						Name:     "target_cadence_zone",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 3, Value: 3},
+						},
+					},
+					{
+						Name:     "target_power_zone",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 3, Value: 4},
+						},
+					},
+					{
+						Name:     "repeat_steps",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 1, Value: 6},
+						},
+					},
+					{
+						Name:     "repeat_time",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1000,
+						Units:    "s",
+						References: []ProfileReference{
+							{Number: 1, Value: 7},
+						},
+					},
+					{
+						Name:     "repeat_distance",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    100,
+						Units:    "m",
+						References: []ProfileReference{
+							{Number: 1, Value: 8},
+						},
+					},
+					{
+						Name:     "repeat_calories",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "calories",
+						References: []ProfileReference{
+							{Number: 1, Value: 9},
+						},
+					},
+					{
+						Name:     "repeat_hr",
+						Type:     "workout_hr",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "% or bpm",
+						References: []ProfileReference{
+							{Number: 1, Value: 10},
+							{Number: 1, Value: 11},
+						},
+					},
+					{
+						Name:     "repeat_power",
+						Type:     "workout_power",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "% or watts",
+						References: []ProfileReference{
+							{Number: 1, Value: 12},
+							{Number: 1, Value: 13},
+						},
+					},
+				},
+			},
+			5: {
+				Number:   5,
+				Name:     "custom_target_value_low",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "custom_target_speed_low",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1000,
+						Units:    "m/s",
+						References: []ProfileReference{
+							{Number: 3, Value: 0},
+						},
+					},
+					{
+						Name:     "custom_target_heart_rate_low",
+						Type:     "workout_hr",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "% or bpm",
+						References: []ProfileReference{
+							{Number: 3, Value: 1},
+						},
+					},
+					{
+						Name:     "custom_target_cadence_low",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "rpm",
+						References: []ProfileReference{
+							{Number: 3, Value: 3},
+						},
+					},
+					{
+						Name:     "custom_target_power_low",
+						Type:     "workout_power",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "% or watts",
+						References: []ProfileReference{
+							{Number: 3, Value: 4},
+						},
+					},
+				},
+			},
+			6: {
+				Number:   6,
+				Name:     "custom_target_value_high",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "custom_target_speed_high",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1000,
+						Units:    "m/s",
+						References: []ProfileReference{
+							{Number: 3, Value: 0},
+						},
+					},
+					{
+						Name:     "custom_target_heart_rate_high",
+						Type:     "workout_hr",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "% or bpm",
+						References: []ProfileReference{
+							{Number: 3, Value: 1},
+						},
+					},
+					{
+						Name:     "custom_target_cadence_high",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "rpm",
+						References: []ProfileReference{
+							{Number: 3, Value: 3},
+						},
+					},
+					{
+						Name:     "custom_target_power_high",
+						Type:     "workout_power",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "% or watts",
+						References: []ProfileReference{
+							{Number: 3, Value: 4},
+						},
+					},
+				},
+			},
+			7: {
+				Number:   7,
+				Name:     "intensity",
+				Type:     "intensity",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			8: {
+				Number:   8,
+				Name:     "notes",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_Activity: {
+		Name: "activity",
+		Type: GlobalMessageType_Activity,
+		Fields: map[uint8]*ProfileField{
+			253: {
+				Number:   253,
+				Name:     "timestamp",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+			},
+			0: {
+				Number:   0,
+				Name:     "total_timer_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			1: {
+				Number:   1,
+				Name:     "num_sessions",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "type",
+				Type:     "activity",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "event",
+				Type:     "event",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			4: {
+				Number:   4,
+				Name:     "event_type",
+				Type:     "event_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			5: {
+				Number:   5,
+				Name:     "local_timestamp",
+				Type:     "local_date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+			},
+			6: {
+				Number:   6,
+				Name:     "event_group",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_Session: {
+		Name: "session",
+		Type: GlobalMessageType_Session,
+		Fields: map[uint8]*ProfileField{
+			254: {
+				Number:   254,
+				Name:     "message_index",
+				Type:     "message_index",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			253: {
+				Number:   253,
+				Name:     "timestamp",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "s",
+			},
+			0: {
+				Number:   0,
+				Name:     "event",
+				Type:     "event",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "event_type",
+				Type:     "event_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "start_time",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "start_position_lat",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			4: {
+				Number:   4,
+				Name:     "start_position_long",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			5: {
+				Number:   5,
+				Name:     "sport",
+				Type:     "sport",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			6: {
+				Number:   6,
+				Name:     "sub_sport",
+				Type:     "sub_sport",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			7: {
+				Number:   7,
+				Name:     "total_elapsed_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			8: {
+				Number:   8,
+				Name:     "total_timer_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			9: {
+				Number:   9,
+				Name:     "total_distance",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    100,
+				Units:    "m",
+			},
+			10: {
+				Number:   10,
+				Name:     "total_cycles",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "cycles",
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "total_strides",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "strides",
+						References: []ProfileReference{
+							{Number: 5, Value: 1},
+							{Number: 5, Value: 11},
+						},
+					},
+					{
+						Name:     "total_strokes",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "strokes",
+						References: []ProfileReference{
+							{Number: 5, Value: 2},
+							{Number: 5, Value: 5},
+							{Number: 5, Value: 15},
+							{Number: 5, Value: 37},
+						},
+					},
+				},
+			},
+			11: {
+				Number:   11,
+				Name:     "total_calories",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "kcal",
+			},
+			13: {
+				Number:   13,
+				Name:     "total_fat_calories",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "kcal",
+			},
+			14: {
+				Number:   14,
+				Name:     "avg_speed",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1000,
+				Units:    "m/s",
+				Components: []ProfileComponent{
+					{
+						Number: 124,
+						Scale:  1000,
+						Units:  "m/s",
+						Bits:   16,
+					},
+				},
+			},
+			15: {
+				Number:   15,
+				Name:     "max_speed",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1000,
+				Units:    "m/s",
+				Components: []ProfileComponent{
+					{
+						Number: 125,
+						Scale:  1000,
+						Units:  "m/s",
+						Bits:   16,
+					},
+				},
+			},
+			16: {
+				Number:   16,
+				Name:     "avg_heart_rate",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "bpm",
+			},
+			17: {
+				Number:   17,
+				Name:     "max_heart_rate",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "bpm",
+			},
+			18: {
+				Number:   18,
+				Name:     "avg_cadence",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "rpm",
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "avg_running_cadence",
+						Type:     "uint8",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						Units:    "strides/min",
+						References: []ProfileReference{
+							{Number: 5, Value: 1},
+						},
+					},
+				},
+			},
+			19: {
+				Number:   19,
+				Name:     "max_cadence",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "rpm",
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "max_running_cadence",
+						Type:     "uint8",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						Units:    "strides/min",
+						References: []ProfileReference{
+							{Number: 5, Value: 1},
+						},
+					},
+				},
+			},
+			20: {
+				Number:   20,
+				Name:     "avg_power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+			},
+			21: {
+				Number:   21,
+				Name:     "max_power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+			},
+			22: {
+				Number:   22,
+				Name:     "total_ascent",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "m",
+			},
+			23: {
+				Number:   23,
+				Name:     "total_descent",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "m",
+			},
+			24: {
+				Number:   24,
+				Name:     "total_training_effect",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    10,
+			},
+			25: {
+				Number:   25,
+				Name:     "first_lap_index",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			26: {
+				Number:   26,
+				Name:     "num_laps",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			27: {
+				Number:   27,
+				Name:     "event_group",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			28: {
+				Number:   28,
+				Name:     "trigger",
+				Type:     "session_trigger",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			29: {
+				Number:   29,
+				Name:     "nec_lat",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			30: {
+				Number:   30,
+				Name:     "nec_long",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			31: {
+				Number:   31,
+				Name:     "swc_lat",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			32: {
+				Number:   32,
+				Name:     "swc_long",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			33: {
+				Number:   33,
+				Name:     "num_lengths",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "lengths",
+			},
+			34: {
+				Number:   34,
+				Name:     "normalized_power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+			},
+			35: {
+				Number:   35,
+				Name:     "training_stress_score",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    10,
+				Units:    "tss",
+			},
+			36: {
+				Number:   36,
+				Name:     "intensity_factor",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1000,
+				Units:    "if",
+			},
+			43: {
+				Number:   43,
+				Name:     "swim_stroke",
+				Type:     "swim_stroke",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+				Units:    "swim_stroke",
+			},
+			44: {
+				Number:   44,
+				Name:     "pool_length",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    100,
+				Units:    "m",
+			},
+			45: {
+				Number:   45,
+				Name:     "threshold_power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+			},
+			46: {
+				Number:   46,
+				Name:     "pool_length_unit",
+				Type:     "display_measure",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			47: {
+				Number:   47,
+				Name:     "num_active_lengths",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "lengths",
+			},
+			48: {
+				Number:   48,
+				Name:     "total_work",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "J",
+			},
+			49: {
+				Number:   49,
+				Name:     "avg_altitude",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+				Components: []ProfileComponent{
+					{
+						Number: 126,
+						Scale:  5,
+						Offset: 500,
+						Units:  "m",
+						Bits:   16,
+					},
+				},
+			},
+			50: {
+				Number:   50,
+				Name:     "max_altitude",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+				Components: []ProfileComponent{
+					{
+						Number: 128,
+						Scale:  5,
+						Offset: 500,
+						Units:  "m",
+						Bits:   16,
+					},
+				},
+			},
+			71: {
+				Number:   71,
+				Name:     "min_altitude",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+				Components: []ProfileComponent{
+					{
+						Number: 127,
+						Scale:  5,
+						Offset: 500,
+						Units:  "m",
+						Bits:   16,
+					},
+				},
+			},
+			110: {
+				Number:   110,
+				Name:     "sport_profile_name",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			124: {
+				Number:   124,
+				Name:     "enhanced_avg_speed",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "m/s",
+			},
+			125: {
+				Number:   125,
+				Name:     "enhanced_max_speed",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "m/s",
+			},
+			126: {
+				Number:   126,
+				Name:     "enhanced_avg_altitude",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+			},
+			127: {
+				Number:   127,
+				Name:     "enhanced_min_altitude",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+			},
+			128: {
+				Number:   128,
+				Name:     "enhanced_max_altitude",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+			},
+			137: {
+				Number:   137,
+				Name:     "total_anaerobic_training_effect",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    10,
+			},
+		},
+	},
+	GlobalMessageType_Lap: {
+		Name: "lap",
+		Type: GlobalMessageType_Lap,
+		Fields: map[uint8]*ProfileField{
+			254: {
+				Number:   254,
+				Name:     "message_index",
+				Type:     "message_index",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			253: {
+				Number:   253,
+				Name:     "timestamp",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "s",
+			},
+			0: {
+				Number:   0,
+				Name:     "event",
+				Type:     "event",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "event_type",
+				Type:     "event_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "start_time",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "start_position_lat",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			4: {
+				Number:   4,
+				Name:     "start_position_long",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			5: {
+				Number:   5,
+				Name:     "end_position_lat",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			6: {
+				Number:   6,
+				Name:     "end_position_long",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			7: {
+				Number:   7,
+				Name:     "total_elapsed_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			8: {
+				Number:   8,
+				Name:     "total_timer_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			9: {
+				Number:   9,
+				Name:     "total_distance",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    100,
+				Units:    "m",
+			},
+			10: {
+				Number:   10,
+				Name:     "total_cycles",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "cycles",
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "total_strides",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "strides",
+						References: []ProfileReference{
+							{Number: 25, Value: 1},
+							{Number: 25, Value: 11},
+						},
+					},
+					{
+						Name:     "total_strokes",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "strokes",
+						References: []ProfileReference{
+							{Number: 25, Value: 2},
+							{Number: 25, Value: 5},
+							{Number: 25, Value: 15},
+							{Number: 25, Value: 37},
+						},
+					},
+				},
+			},
+			11: {
+				Number:   11,
+				Name:     "total_calories",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "kcal",
+			},
+			12: {
+				Number:   12,
+				Name:     "total_fat_calories",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "kcal",
+			},
+			13: {
+				Number:   13,
+				Name:     "avg_speed",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1000,
+				Units:    "m/s",
+				Components: []ProfileComponent{
+					{
+						Number: 110,
+						Scale:  1000,
+						Units:  "m/s",
+						Bits:   16,
+					},
+				},
+			},
+			14: {
+				Number:   14,
+				Name:     "max_speed",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1000,
+				Units:    "m/s",
+				Components: []ProfileComponent{
+					{
+						Number: 111,
+						Scale:  1000,
+						Units:  "m/s",
+						Bits:   16,
+					},
+				},
+			},
+			15: {
+				Number:   15,
+				Name:     "avg_heart_rate",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "bpm",
+			},
+			16: {
+				Number:   16,
+				Name:     "max_heart_rate",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "bpm",
+			},
+			17: {
+				Number:   17,
+				Name:     "avg_cadence",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "rpm",
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "avg_running_cadence",
+						Type:     "uint8",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						Units:    "strides/min",
+						References: []ProfileReference{
+							{Number: 25, Value: 1},
+						},
+					},
+				},
+			},
+			18: {
+				Number:   18,
+				Name:     "max_cadence",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "rpm",
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "max_running_cadence",
+						Type:     "uint8",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						Units:    "strides/min",
+						References: []ProfileReference{
+							{Number: 25, Value: 1},
+						},
+					},
+				},
+			},
+			19: {
+				Number:   19,
+				Name:     "avg_power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+			},
+			20: {
+				Number:   20,
+				Name:     "max_power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+			},
+			21: {
+				Number:   21,
+				Name:     "total_ascent",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "m",
+			},
+			22: {
+				Number:   22,
+				Name:     "total_descent",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "m",
+			},
+			23: {
+				Number:   23,
+				Name:     "intensity",
+				Type:     "intensity",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			24: {
+				Number:   24,
+				Name:     "lap_trigger",
+				Type:     "lap_trigger",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			25: {
+				Number:   25,
+				Name:     "sport",
+				Type:     "sport",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			26: {
+				Number:   26,
+				Name:     "event_group",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			32: {
+				Number:   32,
+				Name:     "num_lengths",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "lengths",
+			},
+			33: {
+				Number:   33,
+				Name:     "normalized_power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+			},
+			35: {
+				Number:   35,
+				Name:     "first_length_index",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			38: {
+				Number:   38,
+				Name:     "swim_stroke",
+				Type:     "swim_stroke",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+				Units:    "swim_stroke",
+			},
+			39: {
+				Number:   39,
+				Name:     "sub_sport",
+				Type:     "sub_sport",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			40: {
+				Number:   40,
+				Name:     "num_active_lengths",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "lengths",
+			},
+			41: {
+				Number:   41,
+				Name:     "total_work",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "J",
+			},
+			42: {
+				Number:   42,
+				Name:     "avg_altitude",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+				Components: []ProfileComponent{
+					{
+						Number: 112,
+						Scale:  5,
+						Offset: 500,
+						Units:  "m",
+						Bits:   16,
+					},
+				},
+			},
+			43: {
+				Number:   43,
+				Name:     "max_altitude",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+				Components: []ProfileComponent{
+					{
+						Number: 114,
+						Scale:  5,
+						Offset: 500,
+						Units:  "m",
+						Bits:   16,
+					},
+				},
+			},
+			62: {
+				Number:   62,
+				Name:     "min_altitude",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+				Components: []ProfileComponent{
+					{
+						Number: 113,
+						Scale:  5,
+						Offset: 500,
+						Units:  "m",
+						Bits:   16,
+					},
+				},
+			},
+			110: {
+				Number:   110,
+				Name:     "enhanced_avg_speed",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "m/s",
+			},
+			111: {
+				Number:   111,
+				Name:     "enhanced_max_speed",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "m/s",
+			},
+			112: {
+				Number:   112,
+				Name:     "enhanced_avg_altitude",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+			},
+			113: {
+				Number:   113,
+				Name:     "enhanced_min_altitude",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+			},
+			114: {
+				Number:   114,
+				Name:     "enhanced_max_altitude",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+			},
+		},
+	},
+	GlobalMessageType_Length: {
+		Name: "length",
+		Type: GlobalMessageType_Length,
+		Fields: map[uint8]*ProfileField{
+			254: {
+				Number:   254,
+				Name:     "message_index",
+				Type:     "message_index",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			253: {
+				Number:   253,
+				Name:     "timestamp",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+			},
+			0: {
+				Number:   0,
+				Name:     "event",
+				Type:     "event",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "event_type",
+				Type:     "event_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "start_time",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "total_elapsed_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			4: {
+				Number:   4,
+				Name:     "total_timer_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			5: {
+				Number:   5,
+				Name:     "total_strokes",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "strokes",
+			},
+			6: {
+				Number:   6,
+				Name:     "avg_speed",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1000,
+				Units:    "m/s",
+			},
+			7: {
+				Number:   7,
+				Name:     "swim_stroke",
+				Type:     "swim_stroke",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+				Units:    "swim_stroke",
+			},
+			9: {
+				Number:   9,
+				Name:     "avg_swimming_cadence",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "strokes/min",
+			},
+			10: {
+				Number:   10,
+				Name:     "event_group",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			11: {
+				Number:   11,
+				Name:     "total_calories",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "kcal",
+			},
+			12: {
+				Number:   12,
+				Name:     "length_type",
+				Type:     "length_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			18: {
+				Number:   18,
+				Name:     "player_score",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			19: {
+				Number:   19,
+				Name:     "opponent_score",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			20: {
+				Number:   20,
+				Name:     "stroke_count",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "counts",
+				Array:    true,
+			},
+			21: {
+				Number:   21,
+				Name:     "zone_count",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "counts",
+				Array:    true,
+			},
+		},
+	},
+	GlobalMessageType_Record: {
+		Name: "record",
+		Type: GlobalMessageType_Record,
+		Fields: map[uint8]*ProfileField{
+			253: {
+				Number:   253,
+				Name:     "timestamp",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "s",
+			},
+			0: {
+				Number:   0,
+				Name:     "position_lat",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			1: {
+				Number:   1,
+				Name:     "position_long",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1,
+				Units:    "semicircles",
+			},
+			2: {
+				Number:   2,
+				Name:     "altitude",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+				Components: []ProfileComponent{
+					{
+						Number: 78,
+						Scale:  5,
+						Offset: 500,
+						Units:  "m",
+						Bits:   16,
+					},
+				},
+			},
+			3: {
+				Number:   3,
+				Name:     "heart_rate",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "bpm",
+			},
+			4: {
+				Number:   4,
+				Name:     "cadence",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "rpm",
+			},
+			5: {
+				Number:   5,
+				Name:     "distance",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    100,
+				Units:    "m",
+			},
+			6: {
+				Number:   6,
+				Name:     "speed",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1000,
+				Units:    "m/s",
+				Components: []ProfileComponent{
+					{
+						Number: 73,
+						Scale:  1000,
+						Units:  "m/s",
+						Bits:   16,
+					},
+				},
+			},
+			7: {
+				Number:   7,
+				Name:     "power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+			},
+			8: {
+				Number:   8,
+				Name:     "compressed_speed_distance",
+				Type:     "byte",
+				BaseType: BaseTypeNumber_Byte,
+				Scale:    1,
+				Array:    true,
+				Components: []ProfileComponent{
+					{
+						Number: 6,
+						Scale:  100,
+						Units:  "m/s",
+						Bits:   12,
+					},
+					{
+						Number:     5,
+						Scale:      16,
+						Units:      "m",
+						Bits:       12,
+						Accumulate: true,
+					},
+				},
+			},
+			9: {
+				Number:   9,
+				Name:     "grade",
+				Type:     "sint16",
+				BaseType: BaseTypeNumber_Sint16,
+				Scale:    100,
+				Units:    "%",
+			},
+			10: {
+				Number:   10,
+				Name:     "resistance",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			11: {
+				Number:   11,
+				Name:     "time_from_course",
+				Type:     "sint32",
+				BaseType: BaseTypeNumber_Sint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			12: {
+				Number:   12,
+				Name:     "cycle_length",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    100,
+				Units:    "m",
+			},
+			13: {
+				Number:   13,
+				Name:     "temperature",
+				Type:     "sint8",
+				BaseType: BaseTypeNumber_Sint8,
+				Scale:    1,
+				Units:    "C",
+			},
+			17: {
+				Number:   17,
+				Name:     "speed_1s",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    16,
+				Units:    "m/s",
+				Array:    true,
+			},
+			18: {
+				Number:   18,
+				Name:     "cycles",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "cycles",
+				Components: []ProfileComponent{
+					{
+						Number:     19,
+						Scale:      1,
+						Units:      "cycles",
+						Bits:       8,
+						Accumulate: true,
+					},
+				},
+			},
+			19: {
+				Number:   19,
+				Name:     "total_cycles",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "cycles",
+			},
+			28: {
+				Number:   28,
+				Name:     "compressed_accumulated_power",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "watts",
+				Components: []ProfileComponent{
+					{
+						Number:     29,
+						Scale:      1,
+						Units:      "watts",
+						Bits:       16,
+						Accumulate: true,
+					},
+				},
+			},
+			29: {
+				Number:   29,
+				Name:     "accumulated_power",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "watts",
+			},
+			30: {
+				Number:   30,
+				Name:     "left_right_balance",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			31: {
+				Number:   31,
+				Name:     "gps_accuracy",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "m",
+			},
+			32: {
+				Number:   32,
+				Name:     "vertical_speed",
+				Type:     "sint16",
+				BaseType: BaseTypeNumber_Sint16,
+				Scale:    1000,
+				Units:    "m/s",
+			},
+			33: {
+				Number:   33,
+				Name:     "calories",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "kcal",
+			},
+			39: {
+				Number:   39,
+				Name:     "vertical_oscillation",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    10,
+				Units:    "mm",
+			},
+			40: {
+				Number:   40,
+				Name:     "stance_time_percent",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    100,
+				Units:    "percent",
+			},
+			41: {
+				Number:   41,
+				Name:     "stance_time",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    10,
+				Units:    "ms",
+			},
+			42: {
+				Number:   42,
+				Name:     "activity_type",
+				Type:     "activity_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			53: {
+				Number:   53,
+				Name:     "fractional_cadence",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    128,
+				Units:    "rpm",
+			},
+			73: {
+				Number:   73,
+				Name:     "enhanced_speed",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "m/s",
+			},
+			78: {
+				Number:   78,
+				Name:     "enhanced_altitude",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    5,
+				Offset:   500,
+				Units:    "m",
+			},
+			83: {
+				Number:   83,
+				Name:     "vertical_ratio",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    100,
+				Units:    "percent",
+			},
+			85: {
+				Number:   85,
+				Name:     "step_length",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    10,
+				Units:    "mm",
+			},
+		},
+	},
+	GlobalMessageType_Event: {
+		Name: "event",
+		Type: GlobalMessageType_Event,
+		Fields: map[uint8]*ProfileField{
+			253: {
+				Number:   253,
+				Name:     "timestamp",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "s",
+			},
+			0: {
+				Number:   0,
+				Name:     "event",
+				Type:     "event",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "event_type",
+				Type:     "event_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "data16",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Components: []ProfileComponent{
+					{
+						Number: 3,
+						Scale:  1,
+						Bits:   16,
+					},
+				},
+			},
+			3: {
+				Number:   3,
+				Name:     "data",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "timer_trigger",
+						Type:     "timer_trigger",
+						BaseType: BaseTypeNumber_Enum,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 0, Value: 0},
+						},
+					},
+					{
+						Name:     "course_point_index",
+						Type:     "message_index",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 0, Value: 10},
+						},
+					},
+					{
+						Name:     "battery_level",
+						Type:     "uint16",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1000,
+						Units:    "V",
+						References: []ProfileReference{
+							{Number: 0, Value: 11},
+						},
+					},
+					{
+						Name:     "virtual_partner_speed",
+						Type:     "uint16",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1000,
+						Units:    "m/s",
+						References: []ProfileReference{
+							{Number: 0, Value: 12},
+						},
+					},
+					{
+						Name:     "hr_high_alert",
+						Type:     "uint8",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						Units:    "bpm",
+						References: []ProfileReference{
+							{Number: 0, Value: 13},
+						},
+					},
+					{
+						Name:     "hr_low_alert",
+						Type:     "uint8",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						Units:    "bpm",
+						References: []ProfileReference{
+							{Number: 0, Value: 14},
+						},
+					},
+					{
+						Name:     "speed_high_alert",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1000,
+						Units:    "m/s",
+						References: []ProfileReference{
+							{Number: 0, Value: 15},
+						},
+					},
+					{
+						Name:     "speed_low_alert",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1000,
+						Units:    "m/s",
+						References: []ProfileReference{
+							{Number: 0, Value: 16},
+						},
+					},
+					{
+						Name:     "cad_high_alert",
+						Type:     "uint16",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						Units:    "rpm",
+						References: []ProfileReference{
+							{Number: 0, Value: 17},
+						},
+					},
+					{
+						Name:     "cad_low_alert",
+						Type:     "uint16",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						Units:    "rpm",
+						References: []ProfileReference{
+							{Number: 0, Value: 18},
+						},
+					},
+					{
+						Name:     "power_high_alert",
+						Type:     "uint16",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						Units:    "watts",
+						References: []ProfileReference{
+							{Number: 0, Value: 19},
+						},
+					},
+					{
+						Name:     "power_low_alert",
+						Type:     "uint16",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						Units:    "watts",
+						References: []ProfileReference{
+							{Number: 0, Value: 20},
+						},
+					},
+					{
+						Name:     "time_duration_alert",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1000,
+						Units:    "s",
+						References: []ProfileReference{
+							{Number: 0, Value: 23},
+						},
+					},
+					{
+						Name:     "distance_duration_alert",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    100,
+						Units:    "m",
+						References: []ProfileReference{
+							{Number: 0, Value: 24},
+						},
+					},
+					{
+						Name:     "calorie_duration_alert",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "calories",
+						References: []ProfileReference{
+							{Number: 0, Value: 25},
+						},
+					},
+					{
+						Name:     "fitness_equipment_state",
+						Type:     "uint8",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 0, Value: 27},
+						},
+					},
+					{
+						Name:     "sport_point",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Components: []ProfileComponent{
+							{
+								Number: 7,
+								Scale:  1,
+								Bits:   16,
+							},
+							{
+								Number: 8,
+								Scale:  1,
+								Bits:   16,
+							},
+						},
+						References: []ProfileReference{
+							{Number: 0, Value: 33},
+						},
+					},
+					{
+						Name:     "gear_change_data",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Components: []ProfileComponent{
+							{
+								Number: 11,
+								Scale:  1,
+								Bits:   8,
+							},
+							{
+								Number: 12,
+								Scale:  1,
+								Bits:   8,
+							},
+							{
+								Number: 9,
+								Scale:  1,
+								Bits:   8,
+							},
+							{
+								Number: 10,
+								Scale:  1,
+								Bits:   8,
+							},
+						},
+						References: []ProfileReference{
+							{Number: 0, Value: 42},
+							{Number: 0, Value: 43},
+						},
+					},
+					{
+						Name:     "rider_position",
+						Type:     "rider_position_type",
+						BaseType: BaseTypeNumber_Enum,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 0, Value: 44},
+						},
+					},
+					{
+						Name:     "comm_timeout",
+						Type:     "comm_timeout_type",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 0, Value: 47},
+						},
+					},
+				},
+			},
+			4: {
+				Number:   4,
+				Name:     "event_group",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			7: {
+				Number:   7,
+				Name:     "score",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			8: {
+				Number:   8,
+				Name:     "opponent_score",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			9: {
+				Number:   9,
+				Name:     "front_gear_num",
+				Type:     "uint8z",
+				BaseType: BaseTypeNumber_Uint8z,
+				Scale:    1,
+			},
+			10: {
+				Number:   10,
+				Name:     "front_gear",
+				Type:     "uint8z",
+				BaseType: BaseTypeNumber_Uint8z,
+				Scale:    1,
+			},
+			11: {
+				Number:   11,
+				Name:     "rear_gear_num",
+				Type:     "uint8z",
+				BaseType: BaseTypeNumber_Uint8z,
+				Scale:    1,
+			},
+			12: {
+				Number:   12,
+				Name:     "rear_gear",
+				Type:     "uint8z",
+				BaseType: BaseTypeNumber_Uint8z,
+				Scale:    1,
+			},
+			13: {
+				Number:   13,
+				Name:     "device_index",
+				Type:     "device_index",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_DeviceInfo: {
+		Name: "device_info",
+		Type: GlobalMessageType_DeviceInfo,
+		Fields: map[uint8]*ProfileField{
+			253: {
+				Number:   253,
+				Name:     "timestamp",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "s",
+			},
+			0: {
+				Number:   0,
+				Name:     "device_index",
+				Type:     "device_index",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "device_type",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "antplus_device_type",
+						Type:     "antplus_device_type",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 25, Value: 1},
+						},
+					},
+					{
+						Name:     "ant_device_type",
+						Type:     "uint8",
+						BaseType: BaseTypeNumber_Uint8,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 25, Value: 0},
+						},
+					},
+				},
+			},
+			2: {
+				Number:   2,
+				Name:     "manufacturer",
+				Type:     "manufacturer",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "serial_number",
+				Type:     "uint32z",
+				BaseType: BaseTypeNumber_Uint32z,
+				Scale:    1,
+			},
+			4: {
+				Number:   4,
+				Name:     "product",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "favero_product",
+						Type:     "favero_product",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 2, Value: 263},
+						},
+					},
+					{
+						Name:     "garmin_product",
+						Type:     "garmin_product",
+						BaseType: BaseTypeNumber_Uint16,
+						Scale:    1,
+						References: []ProfileReference{
+							{Number: 2, Value: 1},
+							{Number: 2, Value: 15},
+							{Number: 2, Value: 13},
+							{Number: 2, Value: 89},
+						},
+					},
+				},
+			},
+			5: {
+				Number:   5,
+				Name:     "software_version",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    100,
+			},
+			6: {
+				Number:   6,
+				Name:     "hardware_version",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			7: {
+				Number:   7,
+				Name:     "cum_operating_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "s",
+			},
+			10: {
+				Number:   10,
+				Name:     "battery_voltage",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    256,
+				Units:    "V",
+			},
+			11: {
+				Number:   11,
+				Name:     "battery_status",
+				Type:     "battery_status",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			19: {
+				Number:   19,
+				Name:     "descriptor",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			20: {
+				Number:   20,
+				Name:     "ant_transmission_type",
+				Type:     "uint8z",
+				BaseType: BaseTypeNumber_Uint8z,
+				Scale:    1,
+			},
+			21: {
+				Number:   21,
+				Name:     "ant_device_number",
+				Type:     "uint16z",
+				BaseType: BaseTypeNumber_Uint16z,
+				Scale:    1,
+			},
+			22: {
+				Number:   22,
+				Name:     "ant_network",
+				Type:     "ant_network",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			25: {
+				Number:   25,
+				Name:     "source_type",
+				Type:     "source_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			27: {
+				Number:   27,
+				Name:     "product_name",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			32: {
+				Number:   32,
+				Name:     "battery_level",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "%",
+			},
+		},
+	},
+	GlobalMessageType_Monitoring: {
+		Name: "monitoring",
+		Type: GlobalMessageType_Monitoring,
+		Fields: map[uint8]*ProfileField{
+			253: {
+				Number:   253,
+				Name:     "timestamp",
+				Type:     "date_time",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "s",
+			},
+			0: {
+				Number:   0,
+				Name:     "device_index",
+				Type:     "device_index",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "calories",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "kcal",
+			},
+			2: {
+				Number:   2,
+				Name:     "distance",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    100,
+				Units:    "m",
+			},
+			3: {
+				Number:     3,
+				Name:       "cycles",
+				Type:       "uint32",
+				BaseType:   BaseTypeNumber_Uint32,
+				Scale:      2,
+				Units:      "cycles",
+				Accumulate: true,
+				Subfields: []ProfileSubfield{
+					{
+						Name:     "steps",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    1,
+						Units:    "steps",
+						References: []ProfileReference{
+							{Number: 5, Value: 6},
+							{Number: 5, Value: 1},
+						},
+					},
+					{
+						Name:     "strokes",
+						Type:     "uint32",
+						BaseType: BaseTypeNumber_Uint32,
+						Scale:    2,
+						Units:    "strokes",
+						References: []ProfileReference{
+							{Number: 5, Value: 2},
+							{Number: 5, Value: 5},
+						},
+					},
+				},
+			},
+			4: {
+				Number:   4,
+				Name:     "active_time",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1000,
+				Units:    "s",
+			},
+			5: {
+				Number:   5,
+				Name:     "activity_type",
+				Type:     "activity_type",
+				BaseType: BaseTypeNumber_Enum,
+				Scale:    1,
+			},
+			6: {
+				Number:   6,
+				Name:     "activity_subtype",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			24: {
+				Number:   24,
+				Name:     "current_activity_type_intensity",
+				Type:     "byte",
+				BaseType: BaseTypeNumber_Byte,
+				Scale:    1,
+				Components: []ProfileComponent{
+					{
+						Number: 5,
+						Scale:  1,
+						Bits:   5,
+					},
+					{
+						Number: 28,
+						Scale:  1,
+						Bits:   3,
+					},
+				},
+			},
+			26: {
+				Number:   26,
+				Name:     "timestamp_16",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "s",
+			},
+			27: {
+				Number:   27,
+				Name:     "heart_rate",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+				Units:    "bpm",
+			},
+			28: {
+				Number:   28,
+				Name:     "intensity",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    10,
+			},
+			29: {
+				Number:   29,
+				Name:     "duration_min",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+				Units:    "min",
+			},
+			30: {
+				Number:   30,
+				Name:     "duration",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+				Units:    "s",
+			},
+		},
+	},
+	GlobalMessageType_Hrv: {
+		Name: "hrv",
+		Type: GlobalMessageType_Hrv,
+		Fields: map[uint8]*ProfileField{
+			0: {
+				Number:   0,
+				Name:     "time",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1000,
+				Units:    "s",
+				Array:    true,
+			},
+		},
+	},
+	GlobalMessageType_DeveloperDataId: {
+		Name: "developer_data_id",
+		Type: GlobalMessageType_DeveloperDataId,
+		Fields: map[uint8]*ProfileField{
+			0: {
+				Number:   0,
+				Name:     "developer_id",
+				Type:     "byte",
+				BaseType: BaseTypeNumber_Byte,
+				Scale:    1,
+				Array:    true,
+			},
+			1: {
+				Number:   1,
+				Name:     "application_id",
+				Type:     "byte",
+				BaseType: BaseTypeNumber_Byte,
+				Scale:    1,
+				Array:    true,
+			},
+			2: {
+				Number:   2,
+				Name:     "manufacturer_id",
+				Type:     "manufacturer",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "developer_data_index",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			4: {
+				Number:   4,
+				Name:     "application_version",
+				Type:     "uint32",
+				BaseType: BaseTypeNumber_Uint32,
+				Scale:    1,
+			},
+		},
+	},
+	GlobalMessageType_FieldDescription: {
+		Name: "field_description",
+		Type: GlobalMessageType_FieldDescription,
+		Fields: map[uint8]*ProfileField{
+			0: {
+				Number:   0,
+				Name:     "developer_data_index",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			1: {
+				Number:   1,
+				Name:     "field_definition_number",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			2: {
+				Number:   2,
+				Name:     "fit_base_type_id",
+				Type:     "fit_base_type",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			3: {
+				Number:   3,
+				Name:     "field_name",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+				Array:    true,
+			},
+			4: {
+				Number:   4,
+				Name:     "array",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			5: {
+				Number:   5,
+				Name:     "components",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			6: {
+				Number:   6,
+				Name:     "scale",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+			7: {
+				Number:   7,
+				Name:     "offset",
+				Type:     "sint8",
+				BaseType: BaseTypeNumber_Sint8,
+				Scale:    1,
+			},
+			8: {
+				Number:   8,
+				Name:     "units",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+				Array:    true,
+			},
+			9: {
+				Number:   9,
+				Name:     "bits",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			10: {
+				Number:   10,
+				Name:     "accumulate",
+				Type:     "string",
+				BaseType: BaseTypeNumber_String,
+				Scale:    1,
+			},
+			13: {
+				Number:   13,
+				Name:     "fit_base_unit_id",
+				Type:     "uint16",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			14: {
+				Number:   14,
+				Name:     "native_mesg_num",
+				Type:     "mesg_num",
+				BaseType: BaseTypeNumber_Uint16,
+				Scale:    1,
+			},
+			15: {
+				Number:   15,
+				Name:     "native_field_num",
+				Type:     "uint8",
+				BaseType: BaseTypeNumber_Uint8,
+				Scale:    1,
+			},
+		},
+	},
+}
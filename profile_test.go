@@ -0,0 +1,89 @@
+package fit
+
+import "testing"
+
+func TestGlobalMessageNumberTypes(t *testing.T) {
+	for number, typ := range GlobalMessageNumber_Types {
+		if uint16(typ) != number {
+			t.Errorf("%d: expected global message type with the same number, got %d", number, typ)
+		}
+		if _, ok := GlobalMessageType_Names[typ]; !ok {
+			t.Errorf("%d: expected a name", number)
+		}
+	}
+}
+
+func TestProfileMessages(t *testing.T) {
+	for typ, m := range ProfileMessages {
+		if m.Type != typ {
+			t.Errorf("%s: expected type %d, got %d", m.Name, typ, m.Type)
+		}
+		for number, f := range m.Fields {
+			if f.Number != number {
+				t.Errorf("%s.%s: expected number %d, got %d", m.Name, f.Name, number, f.Number)
+			}
+			if _, ok := BaseTypeNumber_Infos[f.BaseType]; !ok {
+				t.Errorf("%s.%s: base type %d not defined", m.Name, f.Name, f.BaseType)
+			}
+			for _, c := range f.Components {
+				if _, ok := m.Fields[c.Number]; !ok {
+					t.Errorf("%s.%s: component field %d not defined", m.Name, f.Name, c.Number)
+				}
+			}
+			for _, s := range f.Subfields {
+				for _, ref := range s.References {
+					if _, ok := m.Fields[ref.Number]; !ok {
+						t.Errorf("%s.%s.%s: reference field %d not defined", m.Name, f.Name, s.Name, ref.Number)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestProfileRecord(t *testing.T) {
+	record, ok := ProfileMessages[GlobalMessageType_Record]
+	if !ok {
+		t.Fatal("expected record message")
+	}
+
+	for _, test := range []struct {
+		number   uint8
+		name     string
+		baseType uint8
+		scale    float64
+		offset   float64
+		units    string
+	}{
+		{3, "heart_rate", BaseTypeNumber_Uint8, 1, 0, "bpm"},
+		{5, "distance", BaseTypeNumber_Uint32, 100, 0, "m"},
+		{78, "enhanced_altitude", BaseTypeNumber_Uint32, 5, 500, "m"},
+		{253, "timestamp", BaseTypeNumber_Uint32, 1, 0, "s"},
+	} {
+		f, ok := record.Fields[test.number]
+		if !ok {
+			t.Errorf("%d: expected field", test.number)
+			continue
+		}
+		if f.Name != test.name || f.BaseType != test.baseType || f.Scale != test.scale || f.Offset != test.offset || f.Units != test.units {
+			t.Errorf("%d: expected %s %d %v %v %s, got %s %d %v %v %s", test.number,
+				test.name, test.baseType, test.scale, test.offset, test.units,
+				f.Name, f.BaseType, f.Scale, f.Offset, f.Units)
+		}
+	}
+
+	csd := record.Fields[8]
+	if len(csd.Components) != 2 || csd.Components[1].Number != 5 || csd.Components[1].Bits != 12 || !csd.Components[1].Accumulate {
+		t.Errorf("expected compressed_speed_distance to expand into an accumulated distance, got %+v", csd.Components)
+	}
+}
+
+func TestProfileTypes(t *testing.T) {
+	sport, ok := ProfileTypes["sport"]
+	if !ok {
+		t.Fatal("expected sport type")
+	}
+	if sport.BaseType != BaseTypeNumber_Enum || sport.Values[2] != "cycling" {
+		t.Errorf("expected enum sport with cycling = 2, got %+v", sport)
+	}
+}
@@ -0,0 +1,54 @@
+package fit
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+)
+
+var gzipMagic = []byte{0x1F, 0x8B}
+
+// Decompress returns a reader over the FIT data in r. Gzip compressed input,
+// such as a .fit.gz archive, is detected from its magic number and
+// decompressed transparently; anything else is read as is.
+//
+// The returned reader may read ahead of the data it has returned, so r
+// should not be read from directly afterwards.
+func Decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if !bytes.Equal(magic, gzipMagic) {
+		return br, nil
+	}
+
+	return gzip.NewReader(br)
+}
+
+// DecodeReader decodes a single, possibly gzip compressed, FIT file from r.
+// Like DecodeContext, it returns what was decoded alongside any error.
+func DecodeReader(r io.Reader, opts ...DecodeOption) (*File, error) {
+	dr, err := Decompress(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeContext(context.Background(), dr, opts...)
+}
+
+// DecodeChainReader decodes every FIT file stored back to back in the
+// possibly gzip compressed r. Like DecodeChainContext, it returns what was
+// decoded alongside any error.
+func DecodeChainReader(r io.Reader, opts ...DecodeOption) (FileChain, error) {
+	dr, err := Decompress(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeChainContext(context.Background(), dr, opts...)
+}
@@ -0,0 +1,96 @@
+package fit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeReaderGzip(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/header_14.fit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	w.Write(data)
+	w.Write(data)
+	w.Close()
+
+	expected, err := DecodeReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := DecodeReader(bytes.NewReader(compressed.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(f, expected) {
+		t.Error("decoded gzip file does not match the uncompressed file")
+	}
+
+	chain, err := DecodeChainReader(bytes.NewReader(compressed.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(chain))
+	}
+	for i, f := range chain {
+		if !reflect.DeepEqual(f, expected) {
+			t.Errorf("file %d: decoded gzip file does not match the uncompressed file", i)
+		}
+	}
+}
+
+func TestDecodeReaderPartial(t *testing.T) {
+	data := testFileBytes(
+		testDefinitionRecord(ArchitectureLittleEndian, 0, 20, [3]byte{3, 1, 0x02}),
+		testDataRecord(0, 0x01),
+		testDataRecord(0, 0x02),
+	)
+	truncated := data[:len(data)-CRCSize-1]
+
+	f, err := DecodeReader(bytes.NewReader(truncated))
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("expected %v, got %v", io.ErrUnexpectedEOF, err)
+	}
+	if f == nil || len(f.Records) != 2 {
+		t.Errorf("expected a partial file with 2 records, got %v", f)
+	}
+
+	chain, err := DecodeChainReader(bytes.NewReader(append(append([]byte{}, data...), truncated...)))
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("expected %v, got %v", io.ErrUnexpectedEOF, err)
+	}
+	if len(chain) != 2 || len(chain[1].Records) != 2 {
+		t.Errorf("expected a complete and a partial file, got %v", chain)
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	for _, in := range [][]byte{
+		nil,
+		{0x1F},
+		{0x0E, 0x10, 0x52, 0x08},
+	} {
+		r, err := Decompress(bytes.NewReader(in))
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", in, err)
+			continue
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", in, err)
+			continue
+		}
+		if !bytes.Equal(out, in) {
+			t.Errorf("expected %v, got %v", in, out)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Writer encodes a FIT file one message at a time. It allocates local
+// message types itself, writing a definition message only when a message
+// has a layout that none of the 16 local message types currently holds. When
+// all of them are in use, the least recently used one is redefined.
+//
+// The header, which holds the size of the records, and the file CRC are
+// written by Close. When the underlying writer is an io.WriteSeeker, records
+// are written as they come and the header is patched in place; otherwise
+// they are buffered until Close.
+type Writer struct {
+	w      io.Writer
+	ws     io.WriteSeeker
+	buf    bytes.Buffer
+	header FileHeader
+
+	// start is the offset of the header within ws.
+	start   int64
+	started bool
+	closed  bool
+	err     error
+
+	// crc is the CRC of the records alone and size their length.
+	crc  uint16
+	size int64
+
+	locals [MaxLocalMessageTypes]writerLocal
+	clock  uint64
+}
+
+// writerLocal is the definition a Writer holds for a local message type.
+type writerLocal struct {
+	// layout is the encoded definition message, used to tell whether a
+	// message can reuse the local message type.
+	layout     string
+	definition *DefinitionMessage
+	lastUsed   uint64
+}
+
+// NewWriter returns a Writer that writes a FIT file to w. The file is not
+// complete until Close is called, which does not close w.
+func NewWriter(w io.Writer, opts ...WriterOption) *Writer {
+	writer := &Writer{
+		w:      w,
+		header: newWriterOptions(opts...).header,
+	}
+	if ws, ok := w.(io.WriteSeeker); ok {
+		writer.ws = ws
+	}
+	return writer
+}
+
+// WriteMessage writes a data message of the given global message type
+// holding fields, in order, preceded by a definition message when needed.
+// Fields are sized as by DataMessage.Definition, and fields expanded from
+// components are not written.
+func (w *Writer) WriteMessage(globalType GlobalMessageType, fields []Field) error {
+	return w.WriteDataMessage(&DataMessage{
+		GlobalMessageType: globalType,
+		Fields:            fields,
+	})
+}
+
+// WriteDataMessage writes m, preceded by a definition message when needed.
+// Messages decoded from another file keep the definition they were decoded
+// with, including their developer fields.
+func (w *Writer) WriteDataMessage(m *DataMessage) error {
+	if w.closed {
+		return ErrorWriterClosed
+	}
+	if w.err != nil {
+		return w.err
+	}
+
+	def, err := m.Definition()
+	if err != nil {
+		return err
+	}
+	body, err := m.marshal(def)
+	if err != nil {
+		return err
+	}
+
+	local, err := w.define(def)
+	if err != nil {
+		return w.fail(err)
+	}
+
+	header := DataRecordHeader{
+		Type:             DataRecordHeaderType_Normal,
+		MessageType:      DataRecordMessageType_Data,
+		LocalMessageType: local,
+	}
+	data, err := header.MarshalBinary()
+	if err != nil {
+		return w.fail(err)
+	}
+	return w.write(append(data, body...))
+}
+
+// define returns the local message type holding def, writing a definition
+// message for it first if no local message type does.
+func (w *Writer) define(def *DefinitionMessage) (uint8, error) {
+	developerData := false
+	for _, fd := range def.Fields {
+		if fd.Type == DataRecordFieldType_Developer {
+			developerData = true
+		}
+	}
+	body, err := def.marshal(developerData)
+	if err != nil {
+		return 0, err
+	}
+	layout := string(body)
+
+	w.clock++
+
+	// Reuse the local message type holding the layout, or else take a free
+	// one or the least recently used one.
+	local := 0
+	for i := range w.locals {
+		if w.locals[i].definition != nil && w.locals[i].layout == layout {
+			w.locals[i].lastUsed = w.clock
+			return uint8(i), nil
+		}
+		if w.locals[i].lastUsed < w.locals[local].lastUsed {
+			local = i
+		}
+	}
+
+	header := DataRecordHeader{
+		Type:             DataRecordHeaderType_Normal,
+		MessageType:      DataRecordMessageType_Definition,
+		LocalMessageType: uint8(local),
+		DeveloperData:    developerData,
+	}
+	data, err := header.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	if err := w.write(append(data, body...)); err != nil {
+		return 0, err
+	}
+
+	w.locals[local] = writerLocal{
+		layout:     layout,
+		definition: def,
+		lastUsed:   w.clock,
+	}
+	return uint8(local), nil
+}
+
+// begin reserves room for the header. When w cannot seek, it falls back to
+// buffering the records.
+func (w *Writer) begin() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+
+	if w.ws != nil {
+		start, err := w.ws.Seek(0, io.SeekCurrent)
+		if err != nil {
+			// Not every io.WriteSeeker can seek, os.Stdout on a pipe
+			// being the usual example.
+			w.ws = nil
+			return nil
+		}
+		w.start = start
+
+		header, err := w.header.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if _, err := w.ws.Write(make([]byte, len(header))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes the bytes of whole records.
+func (w *Writer) write(data []byte) error {
+	if err := w.begin(); err != nil {
+		return w.fail(err)
+	}
+	if w.size+int64(len(data)) > math.MaxUint32 {
+		return w.fail(fmt.Errorf("records exceed %d bytes", uint32(math.MaxUint32)))
+	}
+
+	if w.ws != nil {
+		if _, err := w.ws.Write(data); err != nil {
+			return w.fail(err)
+		}
+	} else {
+		w.buf.Write(data)
+	}
+	w.crc = UpdateCRC16(w.crc, data)
+	w.size += int64(len(data))
+	return nil
+}
+
+// fail records an error after which the file can no longer be completed.
+func (w *Writer) fail(err error) error {
+	w.err = err
+	return err
+}
+
+// Close writes the header, holding the size of the records, and the file
+// CRC. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrorWriterClosed
+	}
+	w.closed = true
+
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.begin(); err != nil {
+		return err
+	}
+
+	w.header.DataSize = uint32(w.size)
+	header, err := w.header.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	crc := make([]byte, CRCSize)
+	binary.LittleEndian.PutUint16(crc, combineCRC16(ChecksumCRC16(header), w.crc, w.size))
+
+	if w.ws == nil {
+		for _, data := range [][]byte{header, w.buf.Bytes(), crc} {
+			if _, err := w.w.Write(data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if _, err := w.ws.Seek(w.start, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := w.ws.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.ws.Seek(w.start+int64(len(header))+w.size, io.SeekStart); err != nil {
+		return err
+	}
+	_, err = w.ws.Write(crc)
+	return err
+}
+
+// combineCRC16 returns the CRC of a followed by b given the CRC of each and
+// the length of b. The FIT CRC is linear with a zero initial value, so the
+// CRC of a followed by b is the CRC of b xor that of a followed by as many
+// zeros as b has bytes.
+func combineCRC16(crcA, crcB uint16, lenB int64) uint16 {
+	zeros := make([]byte, 4096)
+	for lenB > 0 {
+		n := int64(len(zeros))
+		if lenB < n {
+			n = lenB
+		}
+		crcA = UpdateCRC16(crcA, zeros[:n])
+		lenB -= n
+	}
+	return crcA ^ crcB
+}
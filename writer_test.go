@@ -0,0 +1,223 @@
+package fit
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// testUnseekable is an io.WriteSeeker that cannot seek, like os.Stdout on
+// a pipe.
+type testUnseekable struct {
+	bytes.Buffer
+}
+
+func (w *testUnseekable) Seek(offset int64, whence int) (int64, error) {
+	return 0, errors.New("illegal seek")
+}
+
+func testWriteMessages(t *testing.T, w *Writer) {
+	for i := 0; i < 3; i++ {
+		if err := w.WriteMessage(GlobalMessageType_Record, []Field{
+			{Number: FieldNumberTimestamp, BaseType: BaseTypeNumber_Uint32, Value: uint64(0x1000 + i)},
+			{Number: 3, BaseType: BaseTypeNumber_Uint8, Value: uint64(90 + i)},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.WriteMessage(GlobalMessageType_Event, []Field{
+		{Number: 0, BaseType: BaseTypeNumber_Enum, Value: uint64(0)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteMessage(GlobalMessageType_Record, []Field{
+		{Number: FieldNumberTimestamp, BaseType: BaseTypeNumber_Uint32, Value: uint64(0x1003)},
+		{Number: 3, BaseType: BaseTypeNumber_Uint8, Value: uint64(93)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	testWriteMessages(t, w)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+
+	var definitions, data []DataRecord
+	for _, record := range f.Records {
+		if record.DefinitionMessage != nil {
+			definitions = append(definitions, record)
+		} else {
+			data = append(data, record)
+		}
+	}
+	if len(definitions) != 2 || len(data) != 5 {
+		t.Fatalf("expected 2 definitions and 5 data messages, got %d and %d", len(definitions), len(data))
+	}
+	if definitions[0].Header.LocalMessageType != 0 || definitions[1].Header.LocalMessageType != 1 {
+		t.Errorf("expected local message types 0 and 1, got %d and %d", definitions[0].Header.LocalMessageType, definitions[1].Header.LocalMessageType)
+	}
+	for i, record := range data {
+		if record.DataMessage.GlobalMessageType == GlobalMessageType_Event {
+			continue
+		}
+		field, _ := record.DataMessage.FieldByName("heart_rate")
+		if v, ok := field.Uint64(); !ok || v != uint64(90+i-i/4) {
+			t.Errorf("record %d: expected heart rate %d, got %v", i, 90+i-i/4, field.Value)
+		}
+	}
+
+	// The file matches the one the encoder writes for the same records.
+	out, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, buf.Bytes()) {
+		t.Errorf("expected\n%x\ngot\n%x", out, buf.Bytes())
+	}
+}
+
+func TestWriterWriteSeeker(t *testing.T) {
+	var expected bytes.Buffer
+	w := NewWriter(&expected, WithHeader(FileHeader{Size: MinimumHeaderSize, ProtocolVersion: 0x20, ProfileVersion: 2130}))
+	testWriteMessages(t, w)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := ioutil.TempFile("", "writer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	// The file starts part way through the underlying writer.
+	prefix := []byte("prefix")
+	if _, err := file.Write(prefix); err != nil {
+		t.Fatal(err)
+	}
+
+	w = NewWriter(file, WithHeader(FileHeader{Size: MinimumHeaderSize, ProtocolVersion: 0x20, ProfileVersion: 2130}))
+	testWriteMessages(t, w)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write([]byte("suffix")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append(append(prefix, expected.Bytes()...), "suffix"...)
+	if !bytes.Equal(out, want) {
+		t.Errorf("expected\n%x\ngot\n%x", want, out)
+	}
+
+	unseekable := new(testUnseekable)
+	w = NewWriter(unseekable, WithHeader(FileHeader{Size: MinimumHeaderSize, ProtocolVersion: 0x20, ProfileVersion: 2130}))
+	testWriteMessages(t, w)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(unseekable.Bytes(), expected.Bytes()) {
+		t.Errorf("expected\n%x\ngot\n%x", expected.Bytes(), unseekable.Bytes())
+	}
+}
+
+func TestWriterEviction(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	layout := func(i int) error {
+		return w.WriteMessage(GlobalMessageType_Record, []Field{
+			{Number: uint8(i), BaseType: BaseTypeNumber_Uint8, Value: uint64(i)},
+		})
+	}
+
+	// Fill the 16 local message types and one more, which evicts layout 0,
+	// then use layout 1 so layout 2 becomes the least recently used.
+	for i := 0; i <= MaxLocalMessageTypes; i++ {
+		if err := layout(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, i := range []int{1, 0} {
+		if err := layout(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+
+	var locals []uint8
+	for _, record := range f.Records {
+		if record.DefinitionMessage != nil {
+			locals = append(locals, record.Header.LocalMessageType)
+		}
+	}
+	expected := []uint8{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 0, 2}
+	if !bytes.Equal(locals, expected) {
+		t.Errorf("expected definitions for local message types %v, got %v", expected, locals)
+	}
+
+	last := f.Records[len(f.Records)-1]
+	if field, ok := last.DataMessage.Field(0); !ok || last.Header.LocalMessageType != 2 || field.Value != uint64(0) {
+		t.Errorf("expected layout 0 in local message type 2, got %+v", last)
+	}
+}
+
+func TestWriterErrors(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	err := w.WriteMessage(GlobalMessageType_Record, []Field{{Number: 3, BaseType: BaseTypeNumber_String, Value: uint64(1)}})
+	if err == nil {
+		t.Error("expected error")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteMessage(GlobalMessageType_Record, nil); !errors.Is(err, ErrorWriterClosed) {
+		t.Errorf("expected %v, got %v", ErrorWriterClosed, err)
+	}
+	if err := w.Close(); !errors.Is(err, ErrorWriterClosed) {
+		t.Errorf("expected %v, got %v", ErrorWriterClosed, err)
+	}
+
+	// A file without records is still a valid file.
+	f := new(File)
+	if _, err := f.ReadAndUnmarshal(bytes.NewReader(buf.Bytes())); err != nil || len(f.Records) != 0 {
+		t.Errorf("expected an empty file, got %d records, %v", len(f.Records), err)
+	}
+}
+
+func TestCombineCRC16(t *testing.T) {
+	a := []byte("header")
+	b := bytes.Repeat([]byte{0x12, 0x34, 0x56}, 3000)
+	if crc := combineCRC16(ChecksumCRC16(a), ChecksumCRC16(b), int64(len(b))); crc != ChecksumCRC16(append(a, b...)) {
+		t.Errorf("expected %#x, got %#x", ChecksumCRC16(append(a, b...)), crc)
+	}
+}